@@ -2,23 +2,28 @@ module github.com/yxlimo/go-jsonrpc-gateway
 
 go 1.18
 
+// github.com/envoyproxy/protoc-gen-validate v1.0.4 requires
+// google.golang.org/protobuf v1.32.0, github.com/spf13/afero v1.10.0,
+// golang.org/x/net v0.20.0, golang.org/x/sys v0.16.0 and golang.org/x/text
+// v0.14.0, so minimal version selection raises them to those versions.
 require (
 	github.com/davecgh/go-spew v1.1.0
+	github.com/envoyproxy/protoc-gen-validate v1.0.4
 	github.com/golang/glog v1.0.0
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.10.0
+	github.com/lyft/protoc-gen-star v0.6.0
+	github.com/spf13/afero v1.10.0
 	github.com/stretchr/testify v1.7.0
+	google.golang.org/genproto v0.0.0-20220407144326-9054f6ed7bac
 	google.golang.org/grpc v1.45.0
-	google.golang.org/protobuf v1.28.0
+	google.golang.org/protobuf v1.32.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/golang/protobuf v1.5.2 // indirect
-	github.com/lyft/protoc-gen-star v0.6.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	github.com/spf13/afero v1.3.3 // indirect
-	golang.org/x/net v0.0.0-20220127200216-cd36cc0744dd // indirect
-	golang.org/x/sys v0.0.0-20211216021012-1d35b9e2eb4e // indirect
-	golang.org/x/text v0.3.7 // indirect
-	google.golang.org/genproto v0.0.0-20220407144326-9054f6ed7bac // indirect
-	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+	golang.org/x/net v0.20.0 // indirect
+	golang.org/x/sys v0.16.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
 )
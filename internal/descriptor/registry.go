@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/golang/glog"
+	"google.golang.org/genproto/googleapis/api/visibility"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
@@ -44,8 +45,8 @@ type Registry struct {
 	// with gRPC-Gateway response, if it uses json tags for marshaling.
 	useJSONNamesForFields bool
 
-	// visibilityRestrictionSelectors is a map of selectors for `google.api.VisibilityRule`s that will be included in the OpenAPI output.
-	visibilityRestrictionSelectors map[string]bool
+	// visibilityRestrictionSelectors are the selectors for `google.api.VisibilityRule`s that will be included in the output.
+	visibilityRestrictionSelectors VisibilitySelectors
 
 	// useGoTemplate determines whether you want to use GO templates
 	// in your protofile comments
@@ -73,7 +74,7 @@ func NewRegistry() *Registry {
 		files:                          make(map[string]*File),
 		pkgMap:                         make(map[string]string),
 		pkgAliases:                     make(map[string]string),
-		visibilityRestrictionSelectors: make(map[string]bool),
+		visibilityRestrictionSelectors: make(VisibilitySelectors),
 		recursiveDepth:                 1000,
 	}
 }
@@ -368,10 +369,7 @@ func (r *Registry) GetOmitEnumDefaultValue() bool {
 
 // SetVisibilityRestrictionSelectors sets the visibility restriction selectors.
 func (r *Registry) SetVisibilityRestrictionSelectors(selectors []string) {
-	r.visibilityRestrictionSelectors = make(map[string]bool)
-	for _, selector := range selectors {
-		r.visibilityRestrictionSelectors[strings.TrimSpace(selector)] = true
-	}
+	r.visibilityRestrictionSelectors = NewVisibilitySelectors(selectors)
 }
 
 // GetVisibilityRestrictionSelectors retrieves he visibility restriction selectors.
@@ -379,6 +377,12 @@ func (r *Registry) GetVisibilityRestrictionSelectors() map[string]bool {
 	return r.visibilityRestrictionSelectors
 }
 
+// IsVisible reports whether an element restricted by rule is visible with the
+// visibility restriction selectors of the registry.
+func (r *Registry) IsVisible(rule *visibility.VisibilityRule) bool {
+	return r.visibilityRestrictionSelectors.IsVisible(rule)
+}
+
 // SetProto3OptionalNullable set proto3OtionalNullable
 func (r *Registry) SetProto3OptionalNullable(proto3OtionalNullable bool) {
 	r.proto3OptionalNullable = proto3OtionalNullable
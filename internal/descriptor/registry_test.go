@@ -1,6 +1,7 @@
 package descriptor
 
 import (
+	"reflect"
 	"testing"
 
 	"google.golang.org/protobuf/compiler/protogen"
@@ -493,3 +494,83 @@ func TestLoadWithStandalone(t *testing.T) {
 		t.Errorf("file.GoPkg = %#v; want %#v", got, want)
 	}
 }
+
+func TestLoadServicesWithVisibility(t *testing.T) {
+	const src = `
+		name: 'example.proto'
+		package: 'example'
+		options < go_package: 'github.com/grpc-ecosystem/grpc-gateway/runtime/internal/example' >
+		message_type < name: 'ExampleMessage' >
+		service <
+			name: 'ExampleService'
+			method <
+				name: 'Public'
+				input_type: '.example.ExampleMessage'
+				output_type: '.example.ExampleMessage'
+			>
+			method <
+				name: 'Admin'
+				input_type: '.example.ExampleMessage'
+				output_type: '.example.ExampleMessage'
+				options < [google.api.method_visibility] < restriction: 'INTERNAL, ADMIN' > >
+			>
+		>
+		service <
+			name: 'InternalService'
+			options < [google.api.api_visibility] < restriction: 'INTERNAL' > >
+			method <
+				name: 'Internal'
+				input_type: '.example.ExampleMessage'
+				output_type: '.example.ExampleMessage'
+			>
+		>
+	`
+	for _, spec := range []struct {
+		selectors []string
+		want      map[string][]string
+		hidden    map[string][]string
+	}{
+		{
+			want:   map[string][]string{"ExampleService": {"Public"}},
+			hidden: map[string][]string{"ExampleService": {"Admin"}},
+		},
+		{
+			selectors: []string{"ADMIN"},
+			want:      map[string][]string{"ExampleService": {"Public", "Admin"}},
+		},
+		{
+			selectors: []string{"INTERNAL"},
+			want: map[string][]string{
+				"ExampleService":  {"Public", "Admin"},
+				"InternalService": {"Internal"},
+			},
+		},
+	} {
+		reg := NewRegistry()
+		reg.SetVisibilityRestrictionSelectors(spec.selectors)
+		loadFile(t, reg, src)
+		file, err := reg.LookupFile("example.proto")
+		if err != nil {
+			t.Fatalf("reg.LookupFile(%q) failed with %v; want success", "example.proto", err)
+		}
+		got := make(map[string][]string)
+		var hidden map[string][]string
+		for _, svc := range file.Services {
+			for _, meth := range svc.Methods {
+				got[svc.GetName()] = append(got[svc.GetName()], meth.GetName())
+			}
+			for _, meth := range svc.HiddenMethods {
+				if hidden == nil {
+					hidden = make(map[string][]string)
+				}
+				hidden[svc.GetName()] = append(hidden[svc.GetName()], meth.GetName())
+			}
+		}
+		if !reflect.DeepEqual(got, spec.want) {
+			t.Errorf("services loaded with selectors %v = %v; want %v", spec.selectors, got, spec.want)
+		}
+		if !reflect.DeepEqual(hidden, spec.hidden) {
+			t.Errorf("hidden methods with selectors %v = %v; want %v", spec.selectors, hidden, spec.hidden)
+		}
+	}
+}
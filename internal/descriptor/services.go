@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"github.com/golang/glog"
+	"google.golang.org/genproto/googleapis/api/visibility"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
@@ -16,6 +18,10 @@ func (r *Registry) loadServices(file *File) error {
 	var svcs []*Service
 	for _, sd := range file.GetService() {
 		glog.V(2).Infof("Registering %s", sd.GetName())
+		if rule := proto.GetExtension(sd.GetOptions(), visibility.E_ApiVisibility).(*visibility.VisibilityRule); !r.IsVisible(rule) {
+			glog.V(2).Infof("Skipping %s restricted to %q", sd.GetName(), rule.GetRestriction())
+			continue
+		}
 		svc := &Service{
 			File:                   file,
 			ServiceDescriptorProto: sd,
@@ -27,6 +33,11 @@ func (r *Registry) loadServices(file *File) error {
 			if err != nil {
 				return err
 			}
+			if rule := proto.GetExtension(md.GetOptions(), visibility.E_MethodVisibility).(*visibility.VisibilityRule); !r.IsVisible(rule) {
+				glog.V(2).Infof("Skipping %s.%s restricted to %q", sd.GetName(), md.GetName(), rule.GetRestriction())
+				svc.HiddenMethods = append(svc.HiddenMethods, meth)
+				continue
+			}
 			svc.Methods = append(svc.Methods, meth)
 		}
 		if len(svc.Methods) == 0 {
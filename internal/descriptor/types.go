@@ -166,6 +166,9 @@ type Service struct {
 	File *File
 	// Methods is the list of methods defined in this service.
 	Methods []*Method
+	// HiddenMethods are the methods of this service left out by visibility
+	// restriction selectors.
+	HiddenMethods []*Method
 	// ForcePrefixedName when set to true, prefixes a type with a package prefix.
 	ForcePrefixedName bool
 }
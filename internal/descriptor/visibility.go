@@ -0,0 +1,46 @@
+package descriptor
+
+import (
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/visibility"
+)
+
+// VisibilitySelectors is a set of `google.api.VisibilityRule` restriction
+// labels. Services, methods, fields and enum values without visibility rule are
+// always visible, the others only if one of their labels is selected.
+type VisibilitySelectors map[string]bool
+
+// ParseVisibilitySelectors returns the selectors listed in the value of the
+// visibility_restriction_selectors parameter of the plugins. They are separated
+// by semicolons since plugin parameters are separated by commas, e.g.
+// "visibility_restriction_selectors=INTERNAL;PREVIEW".
+func ParseVisibilitySelectors(param string) VisibilitySelectors {
+	return NewVisibilitySelectors(strings.Split(param, ";"))
+}
+
+// NewVisibilitySelectors returns the set of the given selectors.
+func NewVisibilitySelectors(selectors []string) VisibilitySelectors {
+	s := make(VisibilitySelectors)
+	for _, selector := range selectors {
+		if selector = strings.TrimSpace(selector); selector != "" {
+			s[selector] = true
+		}
+	}
+	return s
+}
+
+// IsVisible reports whether an element restricted by rule is visible: it has
+// no restriction, or one of the comma separated labels of its restriction is
+// selected.
+func (s VisibilitySelectors) IsVisible(rule *visibility.VisibilityRule) bool {
+	if rule.GetRestriction() == "" {
+		return true
+	}
+	for _, label := range strings.Split(rule.GetRestriction(), ",") {
+		if s[strings.TrimSpace(label)] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+package descriptor
+
+import (
+	"testing"
+
+	"google.golang.org/genproto/googleapis/api/visibility"
+)
+
+func TestVisibilitySelectorsIsVisible(t *testing.T) {
+	for _, spec := range []struct {
+		param       string
+		restriction string
+		want        bool
+	}{
+		{restriction: "", want: true},
+		{restriction: "INTERNAL", want: false},
+		{param: "INTERNAL", restriction: "INTERNAL", want: true},
+		{param: "PREVIEW", restriction: "INTERNAL", want: false},
+		{param: " PREVIEW ; INTERNAL", restriction: "INTERNAL", want: true},
+		{param: "PREVIEW", restriction: "INTERNAL, PREVIEW", want: true},
+		{param: ";", restriction: "INTERNAL,", want: false},
+	} {
+		rule := &visibility.VisibilityRule{Restriction: spec.restriction}
+		if got := ParseVisibilitySelectors(spec.param).IsVisible(rule); got != spec.want {
+			t.Errorf("ParseVisibilitySelectors(%q).IsVisible(%q) = %t; want %t", spec.param, spec.restriction, got, spec.want)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package jsonschema
+
+import (
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+)
+
+// FieldBehavior applies the google.api.field_behavior annotations in opts, the
+// options of a field, to schema, the schema of the field. It returns schema
+// marked readOnly or writeOnly if the field is output or input only, and
+// whether the field is required.
+func FieldBehavior(opts proto.Message, schema *Schema) (*Schema, bool) {
+	var required bool
+	behaviors, _ := proto.GetExtension(opts, annotations.E_FieldBehavior).([]annotations.FieldBehavior)
+	for _, behavior := range behaviors {
+		switch behavior {
+		case annotations.FieldBehavior_REQUIRED:
+			required = true
+		case annotations.FieldBehavior_OUTPUT_ONLY:
+			schema = WithKeywords(schema)
+			schema.ReadOnly = true
+		case annotations.FieldBehavior_INPUT_ONLY:
+			schema = WithKeywords(schema)
+			schema.WriteOnly = true
+		}
+	}
+	return schema, required
+}
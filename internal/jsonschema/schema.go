@@ -0,0 +1,113 @@
+// Package jsonschema describes the protojson encoding of protobuf messages with
+// the JSON Schemas of OpenAPI and OpenRPC documents.
+package jsonschema
+
+import "encoding/json"
+
+// Dialect is the flavour of JSON Schema of a document.
+type Dialect int
+
+const (
+	// OpenAPI is the schema object of OpenAPI 3.0, which has no null type but
+	// a nullable keyword, and whose exclusive bounds are booleans qualifying
+	// minimum and maximum.
+	OpenAPI Dialect = iota
+	// Draft07 is JSON Schema draft 7, the schemas of OpenRPC.
+	Draft07
+)
+
+// Schema is a schema object of OpenAPI 3.0 or a JSON Schema draft 7, depending
+// on the dialect it is generated for.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	Not                  *Schema            `json:"not,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	ReadOnly             bool               `json:"readOnly,omitempty"`
+	WriteOnly            bool               `json:"writeOnly,omitempty"`
+	Deprecated           bool               `json:"deprecated,omitempty"`
+	Default              json.RawMessage    `json:"default,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	// ExclusiveMinimum is true, making Minimum exclusive, in OpenAPI and
+	// the exclusive bound itself in JSON Schema draft 7
+	ExclusiveMinimum interface{} `json:"exclusiveMinimum,omitempty"`
+	Maximum          *float64    `json:"maximum,omitempty"`
+	// ExclusiveMaximum is true, making Maximum exclusive, in OpenAPI and
+	// the exclusive bound itself in JSON Schema draft 7
+	ExclusiveMaximum interface{} `json:"exclusiveMaximum,omitempty"`
+	MinLength        *uint64     `json:"minLength,omitempty"`
+	MaxLength        *uint64     `json:"maxLength,omitempty"`
+	MinItems         *uint64     `json:"minItems,omitempty"`
+	MaxItems         *uint64     `json:"maxItems,omitempty"`
+	UniqueItems      bool        `json:"uniqueItems,omitempty"`
+	MinProperties    *uint64     `json:"minProperties,omitempty"`
+	MaxProperties    *uint64     `json:"maxProperties,omitempty"`
+	Required         []string    `json:"required,omitempty"`
+	Pattern          string      `json:"pattern,omitempty"`
+	// Discriminator is set on the schema of a oneof
+	Discriminator *Discriminator `json:"x-discriminator,omitempty"`
+}
+
+// Discriminator hints which member of a oneof a value sets. An OpenAPI
+// discriminator selects an alternative by the value of a property, whereas
+// protojson tells the members apart by which property is present, so the
+// mapping is from the name of each member property to its schema.
+type Discriminator struct {
+	OneOf   string            `json:"oneOf"`
+	Mapping map[string]string `json:"mapping"`
+}
+
+// WithKeywords returns a schema equivalent to schema to which keywords can be
+// added. schema may be a reference, whose siblings are ignored, or a shared
+// well known type schema.
+func WithKeywords(schema *Schema) *Schema {
+	if schema.Ref != "" {
+		return &Schema{AllOf: []*Schema{schema}}
+	}
+	c := *schema
+	return &c
+}
+
+// Nullable returns schema allowing null as well.
+func (d Dialect) Nullable(schema *Schema) *Schema {
+	if d == Draft07 {
+		return &Schema{AnyOf: []*Schema{schema, {Type: "null"}}}
+	}
+	nullable := WithKeywords(schema)
+	nullable.Nullable = true
+	return nullable
+}
+
+// Minimum sets the lower bound of schema, exclusive or not.
+func (d Dialect) Minimum(schema *Schema, bound float64, exclusive bool) {
+	switch {
+	case !exclusive:
+		schema.Minimum = &bound
+	case d == Draft07:
+		schema.ExclusiveMinimum = bound
+	default:
+		schema.Minimum, schema.ExclusiveMinimum = &bound, true
+	}
+}
+
+// Maximum sets the upper bound of schema, exclusive or not.
+func (d Dialect) Maximum(schema *Schema, bound float64, exclusive bool) {
+	switch {
+	case !exclusive:
+		schema.Maximum = &bound
+	case d == Draft07:
+		schema.ExclusiveMaximum = bound
+	default:
+		schema.Maximum, schema.ExclusiveMaximum = &bound, true
+	}
+}
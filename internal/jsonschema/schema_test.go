@@ -0,0 +1,49 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDialect(t *testing.T) {
+	for _, spec := range []struct {
+		dialect  Dialect
+		nullable string
+		bounds   string
+		null     string
+	}{
+		{
+			dialect:  OpenAPI,
+			nullable: `{"type":"string","nullable":true}`,
+			bounds:   `{"minimum":0,"exclusiveMinimum":true,"maximum":1}`,
+			null:     `{"nullable":true,"enum":[null]}`,
+		},
+		{
+			dialect:  Draft07,
+			nullable: `{"anyOf":[{"type":"string"},{"type":"null"}]}`,
+			bounds:   `{"exclusiveMinimum":0,"maximum":1}`,
+			null:     `{"type":"null"}`,
+		},
+	} {
+		bounds := &Schema{}
+		spec.dialect.Minimum(bounds, 0, true)
+		spec.dialect.Maximum(bounds, 1, false)
+		null, _ := spec.dialect.WellKnownType(nullValueName)
+		for _, check := range []struct {
+			schema *Schema
+			want   string
+		}{
+			{schema: spec.dialect.Nullable(&Schema{Type: "string"}), want: spec.nullable},
+			{schema: bounds, want: spec.bounds},
+			{schema: null, want: spec.null},
+		} {
+			got, err := json.Marshal(check.schema)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != check.want {
+				t.Errorf("dialect %d: got %s; want %s", spec.dialect, got, check.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package jsonschema
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// wktSchemas are the schemas of the well known types, which protojson encodes
+// specially rather than as messages, by full name.
+var wktSchemas = map[protoreflect.FullName]*Schema{
+	"google.protobuf.Any": {
+		Type: "object",
+		Description: "Any message, identified by its type URL in @type. The fields of the message are siblings of @type, " +
+			"unless it is a well known type, encoded as a value field.",
+		Properties: map[string]*Schema{
+			"@type": {Type: "string"},
+		},
+		AdditionalProperties: &Schema{},
+		Required:             []string{"@type"},
+	},
+	"google.protobuf.FieldMask": {
+		Type:        "string",
+		Description: `Comma-separated paths of fields, in lowerCamelCase, e.g. "user.displayName,photo".`,
+	},
+	"google.protobuf.Timestamp": {
+		Type:        "string",
+		Format:      "date-time",
+		Description: `RFC 3339 date-time in UTC, e.g. "1972-01-01T10:00:20.021Z".`,
+	},
+	"google.protobuf.Duration": {
+		Type:        "string",
+		Description: `Signed seconds with up to nine fractional digits, suffixed with "s", e.g. "1.5s".`,
+		Pattern:     `^-?\d+(\.\d+)?s$`,
+	},
+	"google.protobuf.StringValue": Scalar(protoreflect.StringKind),
+	"google.protobuf.BytesValue":  Scalar(protoreflect.BytesKind),
+	"google.protobuf.Int32Value":  Scalar(protoreflect.Int32Kind),
+	"google.protobuf.UInt32Value": Scalar(protoreflect.Uint32Kind),
+	"google.protobuf.Int64Value":  Scalar(protoreflect.Int64Kind),
+	"google.protobuf.UInt64Value": Scalar(protoreflect.Uint64Kind),
+	"google.protobuf.FloatValue":  Scalar(protoreflect.FloatKind),
+	"google.protobuf.DoubleValue": Scalar(protoreflect.DoubleKind),
+	"google.protobuf.BoolValue":   Scalar(protoreflect.BoolKind),
+	"google.protobuf.Empty": {
+		Type: "object",
+	},
+	"google.protobuf.Struct": {
+		Type:                 "object",
+		AdditionalProperties: valueSchema,
+	},
+	"google.protobuf.Value": valueSchema,
+	"google.protobuf.ListValue": {
+		Type:  "array",
+		Items: valueSchema,
+	},
+}
+
+// valueSchema is the schema of google.protobuf.Value, any JSON value.
+var valueSchema = &Schema{
+	Description: "Any JSON value.",
+}
+
+// nullValueName is the full name of google.protobuf.NullValue, an enum encoded
+// as null.
+const nullValueName protoreflect.FullName = "google.protobuf.NullValue"
+
+// WellKnownType returns the schema of the well known message or enum named
+// name, which must not be modified, and whether it is one.
+func (d Dialect) WellKnownType(name protoreflect.FullName) (*Schema, bool) {
+	if name == nullValueName {
+		if d == Draft07 {
+			return &Schema{Type: "null"}, true
+		}
+		// OpenAPI 3.0 has no null type, so it is a nullable schema
+		// allowing only null
+		return &Schema{Nullable: true, Enum: []interface{}{nil}}, true
+	}
+	schema, ok := wktSchemas[name]
+	return schema, ok
+}
+
+// IsWrapper reports whether the message named name wraps a scalar, encoded as
+// the scalar or null when unset.
+func IsWrapper(name protoreflect.FullName) bool {
+	switch name {
+	case "google.protobuf.StringValue", "google.protobuf.BytesValue",
+		"google.protobuf.Int32Value", "google.protobuf.UInt32Value",
+		"google.protobuf.Int64Value", "google.protobuf.UInt64Value",
+		"google.protobuf.FloatValue", "google.protobuf.DoubleValue",
+		"google.protobuf.BoolValue":
+		return true
+	}
+	return false
+}
+
+// Scalar returns the schema of a scalar of kind as encoded by protojson:
+//
+//	double, float                       number, or "NaN", "Infinity", "-Infinity"
+//	int32, sint32, sfixed32             integer
+//	uint32, fixed32                     integer
+//	int64, sint64, sfixed64             decimal string
+//	uint64, fixed64                     decimal string
+//	bool                                boolean
+//	string                              string
+//	bytes                               base64 string
+func Scalar(kind protoreflect.Kind) *Schema {
+	switch kind {
+	case protoreflect.DoubleKind:
+		return floatSchema("double")
+	case protoreflect.FloatKind:
+		return floatSchema("float")
+	case protoreflect.Int64Kind, protoreflect.Sfixed64Kind, protoreflect.Sint64Kind:
+		return &Schema{Type: "string", Format: "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &Schema{Type: "string", Format: "uint64"}
+	case protoreflect.Int32Kind, protoreflect.Sfixed32Kind, protoreflect.Sint32Kind:
+		return &Schema{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &Schema{Type: "integer", Format: "uint32"}
+	case protoreflect.BoolKind:
+		return &Schema{Type: "boolean"}
+	case protoreflect.BytesKind:
+		return &Schema{Type: "string", Format: "byte"}
+	}
+	return &Schema{Type: "string"}
+}
+
+// ScalarDefault returns the JSON encoding of the value of an unset proto3
+// scalar of kind.
+func ScalarDefault(kind protoreflect.Kind) json.RawMessage {
+	switch kind {
+	case protoreflect.BoolKind:
+		return json.RawMessage("false")
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return json.RawMessage(`""`)
+	case protoreflect.Int64Kind, protoreflect.Sfixed64Kind, protoreflect.Sint64Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return json.RawMessage(`"0"`)
+	}
+	return json.RawMessage("0")
+}
+
+// floatSchema returns the schema of a floating point number, which protojson
+// encodes as a string when it is not finite.
+func floatSchema(format string) *Schema {
+	return &Schema{
+		OneOf: []*Schema{
+			{Type: "number", Format: format},
+			{Type: "string", Enum: []interface{}{"NaN", "Infinity", "-Infinity"}},
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package schemagen
+
+import (
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+const paragraphDelimiter = "\n\n"
+
+// Comments returns the leading and trailing comments of e.
+func Comments(e pgs.Entity) string {
+	info := e.SourceCodeInfo()
+	if info == nil {
+		return ""
+	}
+	return JoinParagraphs(trimComment(info.LeadingComments()), trimComment(info.TrailingComments()))
+}
+
+// trimComment removes the space protoc leaves after every comment marker.
+func trimComment(comment string) string {
+	lines := strings.Split(comment, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, " ")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// SplitComments splits comment into a summary, its first paragraph, and a
+// description, the other paragraphs. For titled objects, like schemas, a first
+// paragraph ending with a period is a sentence rather than a title and the
+// whole comment is the description.
+func SplitComments(comment string, titled bool) (summary, description string) {
+	paragraphs := strings.Split(comment, paragraphDelimiter)
+	summary = strings.TrimSpace(paragraphs[0])
+	if titled && strings.HasSuffix(summary, ".") {
+		return "", comment
+	}
+	return summary, strings.TrimSpace(strings.Join(paragraphs[1:], paragraphDelimiter))
+}
+
+// JoinParagraphs joins the non empty paragraphs.
+func JoinParagraphs(paragraphs ...string) string {
+	var nonEmpty []string
+	for _, p := range paragraphs {
+		if p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+	return strings.Join(nonEmpty, paragraphDelimiter)
+}
@@ -0,0 +1,56 @@
+package schemagen
+
+import "testing"
+
+func TestSplitComments(t *testing.T) {
+	for _, spec := range []struct {
+		comment string
+		titled  bool
+
+		summary     string
+		description string
+	}{
+		{
+			comment: "",
+		},
+		{
+			comment: "Echo a message",
+			summary: "Echo a message",
+		},
+		{
+			comment:     "Echo a message\n\nThe reply is the request.\n\nIt is never modified.",
+			summary:     "Echo a message",
+			description: "The reply is the request.\n\nIt is never modified.",
+		},
+		{
+			comment:     "Echo a message\n\nThe reply is the request.",
+			titled:      true,
+			summary:     "Echo a message",
+			description: "The reply is the request.",
+		},
+		{
+			comment:     "Echoes a message.\n\nThe reply is the request.",
+			titled:      true,
+			description: "Echoes a message.\n\nThe reply is the request.",
+		},
+	} {
+		summary, description := SplitComments(spec.comment, spec.titled)
+		if summary != spec.summary || description != spec.description {
+			t.Errorf("SplitComments(%q, %v) = %q, %q; want %q, %q", spec.comment, spec.titled, summary, description, spec.summary, spec.description)
+		}
+	}
+}
+
+func TestTrimComment(t *testing.T) {
+	for _, spec := range []struct {
+		comment string
+		want    string
+	}{
+		{comment: " single line\n", want: "single line"},
+		{comment: " first\n  indented\n\n second\n", want: "first\n indented\n\nsecond"},
+	} {
+		if got := trimComment(spec.comment); got != spec.want {
+			t.Errorf("trimComment(%q) = %q; want %q", spec.comment, got, spec.want)
+		}
+	}
+}
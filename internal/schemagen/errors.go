@@ -0,0 +1,19 @@
+package schemagen
+
+import (
+	"google.golang.org/grpc/codes"
+
+	"github.com/yxlimo/go-jsonrpc-gateway/jsonrpc"
+)
+
+// ErrorCodeName returns the name of the gRPC code behind a JSON-RPC error code
+// returned by jsonrpc.ServeMux.
+func ErrorCodeName(code int32) string {
+	switch code {
+	case jsonrpc.DeadlineExceededErrorCode:
+		return codes.DeadlineExceeded.String()
+	case jsonrpc.InvalidParamsErrorCode:
+		return codes.InvalidArgument.String()
+	}
+	return codes.Code(code).String()
+}
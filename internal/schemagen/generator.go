@@ -0,0 +1,465 @@
+// Package schemagen generates the JSON Schemas of the messages and enums of the
+// proto files given to protoc-gen-star plugins, as protojson encodes them.
+package schemagen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/genproto/googleapis/api/visibility"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/runtime/protoimpl"
+
+	"github.com/yxlimo/go-jsonrpc-gateway/internal/descriptor"
+	"github.com/yxlimo/go-jsonrpc-gateway/internal/jsonschema"
+)
+
+// SchemaRefPrefix prefixes the names of component schemas in references.
+const SchemaRefPrefix = "#/components/schemas/"
+
+// Generator generates the schemas of messages and enums, referencing the
+// component schemas of a document.
+type Generator struct {
+	base    *pgs.ModuleBase
+	dialect jsonschema.Dialect
+
+	// Comments returns the comments documenting e, its leading and
+	// trailing comments by default.
+	Comments func(e pgs.Entity) string
+
+	// useJSONNames names properties after the json_name of fields, as
+	// protojson does by default, instead of their original proto names.
+	useJSONNames bool
+	// nullable marks proto3 optional fields and wrappers as nullable and
+	// documents the default of the other scalars.
+	nullable bool
+	// enumsAsInts describes enums by their numbers instead of their names.
+	enumsAsInts bool
+	// omitEnumDefaultValue leaves the zero value out of singular enum fields
+	// without presence, for gateways whose marshaler does not emit
+	// unpopulated fields. Repeated and map fields still encode it.
+	omitEnumDefaultValue bool
+	// selectors are the google.api.VisibilityRule labels of the services,
+	// methods, fields and enum values to document, besides those without
+	// visibility rule.
+	selectors descriptor.VisibilitySelectors
+
+	// schemas are the component schemas of the document being generated.
+	// names are the names of the component schemas of the messages and
+	// enums referenced by the document, by fully qualified name, and pending
+	// those whose schema is not generated yet
+	schemas map[string]*jsonschema.Schema
+	names   map[string]string
+	pending []pgs.Entity
+}
+
+// New returns a Generator of schemas of dialect, configured by the
+// json_names_for_fields, proto3_optional_nullable, enums_as_ints,
+// omit_enum_default_value and visibility_restriction_selectors plugin
+// parameters. base must be initialized.
+func New(base *pgs.ModuleBase, dialect jsonschema.Dialect) *Generator {
+	g := &Generator{
+		base:     base,
+		dialect:  dialect,
+		Comments: Comments,
+	}
+	params := base.Parameters()
+	var err error
+	g.useJSONNames, err = params.BoolDefault("json_names_for_fields", true)
+	base.CheckErr(err, "invalid json_names_for_fields parameter")
+	g.nullable, err = params.BoolDefault("proto3_optional_nullable", false)
+	base.CheckErr(err, "invalid proto3_optional_nullable parameter")
+	g.enumsAsInts, err = params.BoolDefault("enums_as_ints", false)
+	base.CheckErr(err, "invalid enums_as_ints parameter")
+	g.omitEnumDefaultValue, err = params.BoolDefault("omit_enum_default_value", false)
+	base.CheckErr(err, "invalid omit_enum_default_value parameter")
+	g.selectors = descriptor.ParseVisibilitySelectors(params.Str("visibility_restriction_selectors"))
+	g.Reset(nil)
+	return g
+}
+
+// Reset starts a new document, whose components already define schemas.
+func (g *Generator) Reset(schemas map[string]*jsonschema.Schema) {
+	g.schemas = make(map[string]*jsonschema.Schema, len(schemas))
+	for name, schema := range schemas {
+		g.schemas[name] = schema
+	}
+	g.names = make(map[string]string)
+	g.pending = nil
+}
+
+// Schemas generates the component schemas of the messages and enums referenced
+// by the document and returns the components. Generating a schema references
+// further messages, nested, imported or map values, which are generated in
+// turn until every reference resolves. Each is generated once, so recursive
+// messages terminate.
+func (g *Generator) Schemas() map[string]*jsonschema.Schema {
+	for len(g.pending) > 0 {
+		e := g.pending[0]
+		g.pending = g.pending[1:]
+		name := g.names[e.FullyQualifiedName()]
+		switch e := e.(type) {
+		case pgs.Message:
+			g.base.Debugf("gen message: %s", e.FullyQualifiedName())
+			g.schemas[name] = g.genMessage(e)
+		case pgs.Enum:
+			g.base.Debugf("gen enum: %s", e.FullyQualifiedName())
+			g.schemas[name] = g.genEnum(e)
+		}
+	}
+	return g.schemas
+}
+
+// IsVisible reports whether e is visible with the visibility restriction
+// selectors given as parameter, according to its google.api visibility rule
+// ext.
+func (g *Generator) IsVisible(e pgs.Entity, ext *protoimpl.ExtensionInfo) bool {
+	var rule *visibility.VisibilityRule
+	_, err := e.Extension(ext, &rule)
+	g.base.CheckErr(err, "read visibility of ", e.FullyQualifiedName())
+	return g.selectors.IsVisible(rule)
+}
+
+// wellKnownType returns the schema of e if it is a well known type.
+func (g *Generator) wellKnownType(e pgs.Entity) (*jsonschema.Schema, bool) {
+	return g.dialect.WellKnownType(protoreflect.FullName(strings.TrimPrefix(e.FullyQualifiedName(), ".")))
+}
+
+// IsWellKnownType reports whether msg is a well known type, which is not
+// encoded as an object of its fields.
+func (g *Generator) IsWellKnownType(msg pgs.Message) bool {
+	_, ok := g.wellKnownType(msg)
+	return ok
+}
+
+// MessageSchema returns the schema of a well known type, or else a reference to
+// the component schema of msg.
+func (g *Generator) MessageSchema(msg pgs.Message) *jsonschema.Schema {
+	if wkt, ok := g.wellKnownType(msg); ok {
+		return wkt
+	}
+	return g.schemaRef(msg)
+}
+
+// schemaRef returns a reference to the component schema of e, a message or an
+// enum, which is generated by Schemas.
+func (g *Generator) schemaRef(e pgs.Entity) *jsonschema.Schema {
+	fqn := e.FullyQualifiedName()
+	name, ok := g.names[fqn]
+	if !ok {
+		name = schemaName(fqn)
+		if _, taken := g.schemas[name]; taken {
+			// a message or enum of the same name in another package
+			name = strings.TrimPrefix(fqn, ".")
+		}
+		g.names[fqn] = name
+		// reserve the name until the schema is generated
+		g.schemas[name] = nil
+		g.pending = append(g.pending, e)
+	}
+	return &jsonschema.Schema{
+		Ref: SchemaRefPrefix + name,
+	}
+}
+
+// schemaName returns the name of the component schema of a message or enum, its
+// name qualified by its parent.
+func schemaName(fqn string) string {
+	names := strings.Split(fqn, ".")
+	return names[len(names)-2] + "." + names[len(names)-1]
+}
+
+// RequestSchema returns the schema of msg as a request. It is the component
+// schema of msg, unless msg has output only fields which are left out of an
+// inline copy. The output only fields of nested messages stay in their
+// component schemas, marked readOnly so that clients do not send them.
+func (g *Generator) RequestSchema(msg pgs.Message) *jsonschema.Schema {
+	schema := g.MessageSchema(msg)
+	if schema.Ref == "" {
+		return schema
+	}
+	request := g.genMessage(msg)
+	required := request.Required[:0]
+	for _, name := range request.Required {
+		if !request.Properties[name].ReadOnly {
+			required = append(required, name)
+		}
+	}
+	request.Required = required
+	removed := false
+	for name, property := range request.Properties {
+		if property.ReadOnly {
+			delete(request.Properties, name)
+			removed = true
+		}
+	}
+	if !removed {
+		return schema
+	}
+	return request
+}
+
+// Property is the schema of a field of a message.
+type Property struct {
+	Field    pgs.Field
+	Name     string
+	Schema   *jsonschema.Schema
+	Required bool
+}
+
+// Properties returns the properties of the visible fields of msg, in the order
+// of the fields.
+func (g *Generator) Properties(msg pgs.Message) []*Property {
+	var properties []*Property
+	for _, field := range msg.Fields() {
+		if !g.IsVisible(field, visibility.E_FieldVisibility) {
+			continue
+		}
+		properties = append(properties, g.genProperty(field))
+	}
+	return properties
+}
+
+func (g *Generator) genProperty(field pgs.Field) *Property {
+	property := &Property{
+		Field:    field,
+		Name:     g.fieldName(field),
+		Schema:   g.genFieldPresence(field, g.genEnumDefault(field, g.genValidation(field, g.genSchemaFromField(field)))),
+		Required: g.fieldRules(field).GetMessage().GetRequired(),
+	}
+	if title, description := SplitComments(g.Comments(field), true); title != "" || description != "" {
+		property.Schema = jsonschema.WithKeywords(property.Schema)
+		property.Schema.Title = title
+		property.Schema.Description = JoinParagraphs(description, property.Schema.Description)
+	}
+	if field.Descriptor().GetOptions().GetDeprecated() {
+		property.Schema = jsonschema.WithKeywords(property.Schema)
+		property.Schema.Deprecated = true
+	}
+	var required bool
+	property.Schema, required = jsonschema.FieldBehavior(field.Descriptor().GetOptions(), property.Schema)
+	property.Required = property.Required || required
+	return property
+}
+
+func (g *Generator) genMessage(msg pgs.Message) *jsonschema.Schema {
+	schema := &jsonschema.Schema{Type: "object", Properties: make(map[string]*jsonschema.Schema, len(msg.Fields()))}
+	schema.Title, schema.Description = SplitComments(g.Comments(msg), true)
+	schema.Deprecated = msg.Descriptor().GetOptions().GetDeprecated()
+	for _, property := range g.Properties(msg) {
+		if property.Required {
+			schema.Required = append(schema.Required, property.Name)
+		}
+		schema.Properties[property.Name] = property.Schema
+	}
+	var oneOfs []*jsonschema.Schema
+	for _, oneOf := range msg.RealOneOfs() {
+		if oneOf := g.genOneOf(oneOf); oneOf != nil {
+			oneOfs = append(oneOfs, oneOf)
+		}
+	}
+	switch len(oneOfs) {
+	case 0:
+	case 1:
+		schema.OneOf, schema.Discriminator = oneOfs[0].OneOf, oneOfs[0].Discriminator
+	default:
+		schema.AllOf = oneOfs
+	}
+	return schema
+}
+
+// genFieldPresence marks schema as nullable if field is a proto3 optional field
+// or a wrapper, or documents the default that an unset scalar decodes to. Other
+// embedded messages are never marked nullable: their schema describes the set
+// message, and unset they are omitted rather than decoded to a default.
+func (g *Generator) genFieldPresence(field pgs.Field, schema *jsonschema.Schema) *jsonschema.Schema {
+	if !g.nullable || field.Type().IsRepeated() || field.Type().IsMap() || field.InRealOneOf() {
+		return schema
+	}
+	wrapper := field.Type().IsEmbed() &&
+		jsonschema.IsWrapper(protoreflect.FullName(strings.TrimPrefix(field.Type().Embed().FullyQualifiedName(), ".")))
+	if field.HasOptionalKeyword() || wrapper {
+		return g.dialect.Nullable(schema)
+	}
+	if field.Syntax() == pgs.Proto3 && !field.Type().IsEmbed() {
+		if def := g.scalarDefault(field); def != nil {
+			schema = jsonschema.WithKeywords(schema)
+			schema.Default = def
+		}
+	}
+	return schema
+}
+
+// genEnumDefault returns schema excluding the zero value of field if it is a
+// singular enum field without presence and omit_enum_default_value is set:
+// such a field is left out of messages rather than encoded with its zero
+// value. Elements of repeated and map fields are always encoded.
+func (g *Generator) genEnumDefault(field pgs.Field, schema *jsonschema.Schema) *jsonschema.Schema {
+	typ := field.Type()
+	if !g.omitEnumDefaultValue || !typ.IsEnum() || typ.IsRepeated() || typ.IsMap() || field.HasPresence() {
+		return schema
+	}
+	if _, ok := g.wellKnownType(typ.Enum()); ok {
+		return schema
+	}
+	for _, v := range g.enumValues(typ.Enum()) {
+		if v.Value() == 0 {
+			return &jsonschema.Schema{AllOf: []*jsonschema.Schema{
+				schema,
+				{Not: &jsonschema.Schema{Enum: []interface{}{g.enumValue(v)}}},
+			}}
+		}
+	}
+	return schema
+}
+
+// scalarDefault returns the JSON encoding of the value an unset proto3 scalar
+// or enum field has, or nil if it is an enum whose zero value is omitted.
+func (g *Generator) scalarDefault(field pgs.Field) json.RawMessage {
+	if !field.Type().IsEnum() {
+		return jsonschema.ScalarDefault(protoreflect.Kind(field.Type().ProtoType()))
+	}
+	if _, ok := g.wellKnownType(field.Type().Enum()); ok {
+		return json.RawMessage("null")
+	}
+	if g.omitEnumDefaultValue {
+		return nil
+	}
+	if g.enumsAsInts {
+		return json.RawMessage("0")
+	}
+	def, _ := json.Marshal(field.Type().Enum().Values()[0].Name().String())
+	return def
+}
+
+// genOneOf returns a schema which accepts at most one of the members of oneOf,
+// each alternative being titled after the member it requires, or nil if none of
+// its members is visible. Members are described in the properties of the
+// message.
+func (g *Generator) genOneOf(oneOf pgs.OneOf) *jsonschema.Schema {
+	var alternatives, members []*jsonschema.Schema
+	discriminator := &jsonschema.Discriminator{OneOf: oneOf.Name().String(), Mapping: make(map[string]string)}
+	properties := g.schemaRef(oneOf.Message()).Ref + "/properties/"
+	for _, field := range oneOf.Fields() {
+		if !g.IsVisible(field, visibility.E_FieldVisibility) {
+			continue
+		}
+		name := g.fieldName(field)
+		alternatives = append(alternatives, &jsonschema.Schema{
+			Title:    name,
+			Required: []string{name},
+		})
+		members = append(members, &jsonschema.Schema{Required: []string{name}})
+		discriminator.Mapping[name] = properties + name
+	}
+	if len(members) == 0 {
+		return nil
+	}
+	// an unset oneof is valid and encoded without any of its members
+	alternatives = append(alternatives, &jsonschema.Schema{
+		Title: "none of " + oneOf.Name().String(),
+		Not:   &jsonschema.Schema{AnyOf: members},
+	})
+	return &jsonschema.Schema{OneOf: alternatives, Discriminator: discriminator}
+}
+
+// fieldName returns the name of field in the JSON encoding of its message.
+func (g *Generator) fieldName(field pgs.Field) string {
+	if g.useJSONNames {
+		return field.Descriptor().GetJsonName()
+	}
+	return field.Name().String()
+}
+
+func (g *Generator) genSchemaFromField(field pgs.Field) *jsonschema.Schema {
+	typ := field.Type()
+	switch {
+	case typ.IsMap():
+		// protojson encodes map keys of any type as JSON strings
+		return &jsonschema.Schema{
+			Type:                 "object",
+			AdditionalProperties: g.genSchemaFromElem(typ.Element()),
+		}
+	case typ.IsRepeated():
+		return &jsonschema.Schema{
+			Type:  "array",
+			Items: g.genSchemaFromElem(typ.Element()),
+		}
+	case typ.IsEnum():
+		return g.genSchemaFromEnum(typ.Enum())
+	case typ.IsEmbed():
+		return g.MessageSchema(typ.Embed())
+	}
+	return jsonschema.Scalar(protoreflect.Kind(typ.ProtoType()))
+}
+
+func (g *Generator) genSchemaFromElem(elem pgs.FieldTypeElem) *jsonschema.Schema {
+	switch {
+	case elem.IsEnum():
+		return g.genSchemaFromEnum(elem.Enum())
+	case elem.IsEmbed():
+		return g.MessageSchema(elem.Embed())
+	}
+	return jsonschema.Scalar(protoreflect.Kind(elem.ProtoType()))
+}
+
+// genSchemaFromEnum returns the schema of a well known enum, or else a
+// reference to the component schema of enum.
+func (g *Generator) genSchemaFromEnum(enum pgs.Enum) *jsonschema.Schema {
+	if wkt, ok := g.wellKnownType(enum); ok {
+		return jsonschema.WithKeywords(wkt)
+	}
+	return g.schemaRef(enum)
+}
+
+// genEnum returns the schema of enum values, encoded by their names or by their
+// numbers if enums_as_ints is set.
+func (g *Generator) genEnum(enum pgs.Enum) *jsonschema.Schema {
+	visible := g.enumValues(enum)
+	values := make([]interface{}, 0, len(visible))
+	for _, v := range visible {
+		values = append(values, g.enumValue(v))
+	}
+	schema := &jsonschema.Schema{Type: "string", Enum: values}
+	if g.enumsAsInts {
+		schema = &jsonschema.Schema{Type: "integer", Format: "int32", Enum: values}
+	}
+	schema.Deprecated = enum.Descriptor().GetOptions().GetDeprecated()
+	// values cannot be described or deprecated one by one, so they are listed
+	// in the description of the enum
+	var valueComments []string
+	for _, v := range visible {
+		comment := g.Comments(v)
+		if v.Descriptor().GetOptions().GetDeprecated() {
+			comment = strings.TrimSpace("Deprecated. " + comment)
+		}
+		if comment != "" {
+			valueComments = append(valueComments, fmt.Sprintf(" - %s: %s", v.Name(), comment))
+		}
+	}
+	schema.Description = JoinParagraphs(g.Comments(enum), strings.Join(valueComments, "\n"))
+	return schema
+}
+
+// enumValue returns the JSON encoding of v, its name or its number if
+// enums_as_ints is set.
+func (g *Generator) enumValue(v pgs.EnumValue) interface{} {
+	if g.enumsAsInts {
+		return v.Value()
+	}
+	return v.Name().String()
+}
+
+// enumValues returns the visible values of enum.
+func (g *Generator) enumValues(enum pgs.Enum) []pgs.EnumValue {
+	var values []pgs.EnumValue
+	for _, v := range enum.Values() {
+		if !g.IsVisible(v, visibility.E_ValueVisibility) {
+			continue
+		}
+		values = append(values, v)
+	}
+	return values
+}
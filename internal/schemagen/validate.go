@@ -0,0 +1,253 @@
+package schemagen
+
+import (
+	"strconv"
+
+	"github.com/envoyproxy/protoc-gen-validate/validate"
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/yxlimo/go-jsonrpc-gateway/internal/jsonschema"
+)
+
+// fieldRules returns the protoc-gen-validate rules of field, or nil.
+//
+// The buf.validate constraints of protovalidate are not read: their generated
+// Go types need google.golang.org/protobuf v1.36 and Go 1.23, while this module
+// still supports Go 1.18.
+func (g *Generator) fieldRules(field pgs.Field) *validate.FieldRules {
+	var rules *validate.FieldRules
+	_, err := field.Extension(validate.E_Rules, &rules)
+	g.base.CheckErr(err, "read validate rules of ", field.FullyQualifiedName())
+	return rules
+}
+
+// genValidation returns schema constrained by the protoc-gen-validate rules of
+// field, so that clients can check requests before sending them.
+func (g *Generator) genValidation(field pgs.Field, schema *jsonschema.Schema) *jsonschema.Schema {
+	rules := g.fieldRules(field)
+	if rules == nil {
+		return schema
+	}
+	typ := field.Type()
+	switch {
+	case typ.IsMap():
+		schema = jsonschema.WithKeywords(schema)
+		schema.MinProperties = rules.GetMap().MinPairs
+		schema.MaxProperties = rules.GetMap().MaxPairs
+		if values := rules.GetMap().GetValues(); values != nil {
+			schema.AdditionalProperties = g.genRules(values, schema.AdditionalProperties, typ.Element().Enum())
+		}
+		return schema
+	case typ.IsRepeated():
+		schema = jsonschema.WithKeywords(schema)
+		schema.MinItems = rules.GetRepeated().MinItems
+		schema.MaxItems = rules.GetRepeated().MaxItems
+		schema.UniqueItems = rules.GetRepeated().GetUnique()
+		if items := rules.GetRepeated().GetItems(); items != nil {
+			schema.Items = g.genRules(items, schema.Items, typ.Element().Enum())
+		}
+		return schema
+	}
+	return g.genRules(rules, schema, typ.Enum())
+}
+
+// genRules returns schema constrained by the scalar rules, enum being the enum
+// of the constrained value if any. Rules on bytes and well known types are
+// left out, JSON Schema having no keyword for them.
+func (g *Generator) genRules(rules *validate.FieldRules, schema *jsonschema.Schema, enum pgs.Enum) *jsonschema.Schema {
+	switch rules.GetType().(type) {
+	case *validate.FieldRules_String_:
+		return genStringRules(rules.GetString_(), jsonschema.WithKeywords(schema))
+	case *validate.FieldRules_Bool:
+		if rules.GetBool().Const != nil {
+			schema = jsonschema.WithKeywords(schema)
+			schema.Enum = []interface{}{rules.GetBool().GetConst()}
+		}
+		return schema
+	case *validate.FieldRules_Enum:
+		return g.genEnumRules(rules.GetEnum(), jsonschema.WithKeywords(schema), enum)
+	case *validate.FieldRules_Bytes, *validate.FieldRules_Any, *validate.FieldRules_Duration, *validate.FieldRules_Timestamp, nil:
+		return schema
+	}
+	// the rules of every numeric type have the same fields
+	m := rules.ProtoReflect()
+	typed := m.Get(m.WhichOneof(m.Descriptor().Oneofs().ByName("type"))).Message()
+	return g.genNumericRules(typed, jsonschema.WithKeywords(schema))
+}
+
+func genStringRules(rules *validate.StringRules, schema *jsonschema.Schema) *jsonschema.Schema {
+	if rules.Const != nil {
+		schema.Enum = []interface{}{rules.GetConst()}
+	}
+	if rules.Len != nil {
+		schema.MinLength, schema.MaxLength = rules.Len, rules.Len
+	}
+	if rules.MinLen != nil {
+		schema.MinLength = rules.MinLen
+	}
+	if rules.MaxLen != nil {
+		schema.MaxLength = rules.MaxLen
+	}
+	// protoc-gen-validate patterns are RE2, which is close enough to the
+	// ECMA 262 regular expressions of JSON Schema for common patterns
+	schema.Pattern = rules.GetPattern()
+	for _, v := range rules.GetIn() {
+		schema.Enum = append(schema.Enum, v)
+	}
+	if len(rules.GetNotIn()) > 0 {
+		notIn := &jsonschema.Schema{}
+		for _, v := range rules.GetNotIn() {
+			notIn.Enum = append(notIn.Enum, v)
+		}
+		schema.Not = notIn
+	}
+	switch {
+	case rules.GetEmail():
+		schema.Format = "email"
+	case rules.GetHostname():
+		schema.Format = "hostname"
+	case rules.GetIpv4():
+		schema.Format = "ipv4"
+	case rules.GetIpv6():
+		schema.Format = "ipv6"
+	case rules.GetUri():
+		schema.Format = "uri"
+	case rules.GetUriRef():
+		schema.Format = "uri-reference"
+	case rules.GetUuid():
+		schema.Format = "uuid"
+	}
+	return schema
+}
+
+// genEnumRules restricts the visible values of the enum schema to those allowed
+// by rules, encoded as the schema encodes them.
+func (g *Generator) genEnumRules(rules *validate.EnumRules, schema *jsonschema.Schema, enum pgs.Enum) *jsonschema.Schema {
+	if enum == nil {
+		return schema
+	}
+	if _, ok := g.wellKnownType(enum); ok {
+		return schema
+	}
+	allowed := rules.GetIn()
+	if rules.Const != nil {
+		allowed = []int32{rules.GetConst()}
+	}
+	excluded := make(map[int32]bool, len(rules.GetNotIn()))
+	for _, n := range rules.GetNotIn() {
+		excluded[n] = true
+	}
+	if len(allowed) == 0 && len(excluded) == 0 {
+		return schema
+	}
+	var values []interface{}
+	for _, v := range g.enumValues(enum) {
+		if excluded[v.Value()] ||
+			(len(allowed) > 0 && !containsInt32(allowed, v.Value())) {
+			continue
+		}
+		values = append(values, g.enumValue(v))
+	}
+	schema.Enum = values
+	return schema
+}
+
+func containsInt32(values []int32, value int32) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// genNumericRules constrains schema by the const, lt, lte, gt, gte, in and
+// not_in rules of a numeric type. 64-bit integers are encoded as strings, so
+// only their const, in and not_in rules are kept.
+func (g *Generator) genNumericRules(rules protoreflect.Message, schema *jsonschema.Schema) *jsonschema.Schema {
+	fields := rules.Descriptor().Fields()
+	is64Bit := false
+	switch fields.ByName("const").Kind() {
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		is64Bit = true
+	}
+	value := func(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+		switch fd.Kind() {
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			return v.Float()
+		case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			return strconv.FormatInt(v.Int(), 10)
+		case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			return strconv.FormatUint(v.Uint(), 10)
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+			return v.Uint()
+		}
+		return v.Int()
+	}
+	values := func(name protoreflect.Name) []interface{} {
+		fd := fields.ByName(name)
+		list := rules.Get(fd).List()
+		values := make([]interface{}, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			values = append(values, value(fd, list.Get(i)))
+		}
+		return values
+	}
+	bound := func(name protoreflect.Name) *float64 {
+		fd := fields.ByName(name)
+		if !rules.Has(fd) {
+			return nil
+		}
+		var f float64
+		switch v := rules.Get(fd); fd.Kind() {
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			f = v.Float()
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+			f = float64(v.Uint())
+		default:
+			f = float64(v.Int())
+		}
+		return &f
+	}
+
+	if fd := fields.ByName("const"); rules.Has(fd) {
+		schema.Enum = []interface{}{value(fd, rules.Get(fd))}
+	}
+	if in := values("in"); len(in) > 0 {
+		schema.Enum = in
+	}
+	if notIn := values("not_in"); len(notIn) > 0 {
+		schema.Not = &jsonschema.Schema{Enum: notIn}
+	}
+	if is64Bit {
+		return schema
+	}
+	// the bounds are set in the keywords of the dialect, and compared here
+	lower, upper := &jsonschema.Schema{}, &jsonschema.Schema{}
+	var lowerBound, upperBound *float64
+	if gte := bound("gte"); gte != nil {
+		lowerBound = gte
+		g.dialect.Minimum(lower, *gte, false)
+	}
+	if gt := bound("gt"); gt != nil {
+		lowerBound = gt
+		g.dialect.Minimum(lower, *gt, true)
+	}
+	if lte := bound("lte"); lte != nil {
+		upperBound = lte
+		g.dialect.Maximum(upper, *lte, false)
+	}
+	if lt := bound("lt"); lt != nil {
+		upperBound = lt
+		g.dialect.Maximum(upper, *lt, true)
+	}
+	if lowerBound != nil && upperBound != nil && *lowerBound > *upperBound {
+		// a lower bound above the upper bound excludes the range between them
+		schema.AnyOf = []*jsonschema.Schema{lower, upper}
+		return schema
+	}
+	schema.Minimum, schema.ExclusiveMinimum = lower.Minimum, lower.ExclusiveMinimum
+	schema.Maximum, schema.ExclusiveMaximum = upper.Maximum, upper.ExclusiveMaximum
+	return schema
+}
@@ -0,0 +1,231 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Client calls the methods registered on a ServeMux over HTTP. It is the
+// transport of the <Service>JSONRPCClient types generated by
+// protoc-gen-go-jsonrpc-proxy, which implement the same interfaces as the gRPC
+// clients, less the methods hidden by visibility restrictions.
+type Client struct {
+	endpoint   string
+	httpClient *http.Client
+	marshaler  runtime.Marshaler
+	lastID     uint64
+}
+
+// ClientOption is an option that can be given to a Client on construction.
+type ClientOption func(*Client)
+
+// WithHTTPClient returns a ClientOption which sends calls with c instead of
+// http.DefaultClient.
+func WithHTTPClient(c *http.Client) ClientOption {
+	return func(client *Client) {
+		client.httpClient = c
+	}
+}
+
+// WithClientMarshaler returns a ClientOption which encodes params and decodes
+// results with marshaler, which must match a marshaler of the ServeMux. The
+// default is the default marshaler of ServeMux.
+func WithClientMarshaler(marshaler runtime.Marshaler) ClientOption {
+	return func(client *Client) {
+		client.marshaler = marshaler
+	}
+}
+
+// NewClient returns a Client posting calls to the ServeMux served at endpoint,
+// e.g. "http://localhost:8080/jsonrpc".
+func NewClient(endpoint string, opts ...ClientOption) *Client {
+	c := &Client{
+		endpoint:   endpoint,
+		httpClient: http.DefaultClient,
+		marshaler:  defaultMarshaler,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// clientResponse is a response object as decoded by Client.
+type clientResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int             `json:"code"`
+		Message string          `json:"message"`
+		Data    json.RawMessage `json:"data"`
+	} `json:"error"`
+}
+
+// Call calls method with the params in and decodes its result into out.
+//
+// The deadline of ctx is sent as the timeout of the call, and the outgoing
+// metadata of ctx as headers, as grpc-gateway forwards them to the backend.
+// The response headers carrying metadata are returned through grpc.Header call
+// options; other call options are ignored. Errors are gRPC status errors,
+// converted back from JSON-RPC errors as they are converted by ServeMux.
+func (c *Client) Call(ctx context.Context, method string, in, out proto.Message, opts ...grpc.CallOption) error {
+	params, err := c.marshaler.Marshal(in)
+	if err != nil {
+		return status.Errorf(codes.Internal, "marshal params: %v", err)
+	}
+	msg := &jsonrpcMessage{
+		Version: vsn,
+		ID:      json.RawMessage(strconv.FormatUint(atomic.AddUint64(&c.lastID, 1), 10)),
+		Method:  method,
+		Params:  params,
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout := time.Until(deadline)
+		if timeout <= 0 {
+			return status.Error(codes.DeadlineExceeded, context.DeadlineExceeded.Error())
+		}
+		msg.Timeout = timeout.String()
+	}
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return status.Errorf(codes.Internal, "marshal request: %v", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return status.Errorf(codes.Internal, "create request: %v", err)
+	}
+	// the request object is encoded as JSON but the mux selects its
+	// marshalers from these headers, so they name the client marshaler
+	mime := c.marshaler.ContentType(in)
+	req.Header.Set(contentTypeHeader, mime)
+	req.Header.Set(acceptHeader, mime)
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		for key, values := range md {
+			for _, value := range values {
+				if strings.HasSuffix(key, "-bin") {
+					value = base64.StdEncoding.EncodeToString([]byte(value))
+				}
+				req.Header.Add(runtime.MetadataHeaderPrefix+key, value)
+			}
+		}
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return status.FromContextError(ctxErr).Err()
+		}
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer res.Body.Close()
+	setHeaders(res.Header, opts)
+	content, err := io.ReadAll(io.LimitReader(res.Body, maxRequestContentLength))
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "read response: %v", err)
+	}
+
+	var reply clientResponse
+	if err := json.Unmarshal(content, &reply); err != nil || (reply.Error == nil && reply.Result == nil) {
+		// not a response object, e.g. a request rejected before being
+		// parsed
+		httpErr := HTTPError{StatusCode: res.StatusCode, Status: res.Status, Body: content}
+		return status.Error(httpStatusCode(res.StatusCode), httpErr.Error())
+	}
+	if reply.Error != nil {
+		s := &spb.Status{
+			Code:    int32(statusCode(reply.Error.Code)),
+			Message: reply.Error.Message,
+			Details: c.errorDetails(reply.Error.Data),
+		}
+		return status.ErrorProto(s)
+	}
+	if err := c.marshaler.Unmarshal(reply.Result, out); err != nil {
+		return status.Errorf(codes.Internal, "unmarshal result: %v", err)
+	}
+	return nil
+}
+
+// errorDetails decodes the details of a google.rpc.Status from the data of a
+// JSON-RPC error. Details of unknown types are left out.
+func (c *Client) errorDetails(data json.RawMessage) []*anypb.Any {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil
+	}
+	details := make([]*anypb.Any, 0, len(raw))
+	for _, r := range raw {
+		detail := &anypb.Any{}
+		if err := c.marshaler.Unmarshal(r, detail); err != nil {
+			continue
+		}
+		details = append(details, detail)
+	}
+	return details
+}
+
+// setHeaders sets the metadata found in the response headers h into the
+// grpc.Header call options among opts.
+func setHeaders(h http.Header, opts []grpc.CallOption) {
+	md := metadata.MD{}
+	for key, values := range h {
+		if strings.HasPrefix(key, runtime.MetadataHeaderPrefix) {
+			md.Append(strings.TrimPrefix(key, runtime.MetadataHeaderPrefix), values...)
+		}
+	}
+	for _, opt := range opts {
+		if header, ok := opt.(grpc.HeaderCallOption); ok {
+			*header.HeaderAddr = md
+		}
+	}
+}
+
+// statusCode returns the gRPC code of a JSON-RPC error code, the inverse of
+// errorCode. The error codes reserved by JSON-RPC map to the closest gRPC code.
+func statusCode(code int) codes.Code {
+	switch code {
+	case DeadlineExceededErrorCode:
+		return codes.DeadlineExceeded
+	case -32700, -32600, InvalidParamsErrorCode:
+		return codes.InvalidArgument
+	case -32601:
+		return codes.Unimplemented
+	}
+	if code < 0 || code > int(codes.Unauthenticated) {
+		return codes.Unknown
+	}
+	return codes.Code(code)
+}
+
+// httpStatusCode returns the gRPC code of a HTTP response which is not a
+// JSON-RPC response, as gRPC clients map HTTP statuses.
+func httpStatusCode(code int) codes.Code {
+	switch code {
+	case http.StatusBadRequest:
+		return codes.Internal
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.Unimplemented
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return codes.Unavailable
+	}
+	return codes.Unknown
+}
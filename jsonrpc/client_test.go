@@ -0,0 +1,173 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestClientCall(t *testing.T) {
+	mux := NewServeMux()
+	mux.Register("Service.Echo", echoHandler)
+	mux.Register("Service.Metadata", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+		ctx, err := runtime.AnnotateContext(req.Context(), mux.RuntimeMux(), req, "/Service/Metadata")
+		if err != nil {
+			return nil, req.Context(), err
+		}
+		md, _ := metadata.FromOutgoingContext(ctx)
+		result, err := structpb.NewStruct(map[string]interface{}{"user": md.Get("user")[0]})
+		ctx = runtime.NewServerMetadataContext(ctx, runtime.ServerMetadata{HeaderMD: metadata.Pairs("request-id", "42")})
+		return result, ctx, err
+	})
+	mux.Register("Service.Deadline", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+		deadline, ok := req.Context().Deadline()
+		if !ok {
+			return nil, req.Context(), status.Error(codes.FailedPrecondition, "no deadline")
+		}
+		return structpb.NewNumberValue(time.Until(deadline).Seconds()), req.Context(), nil
+	})
+	mux.Register("Service.Fail", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+		s, _ := status.New(codes.PermissionDenied, "denied").WithDetails(&errdetails.ErrorInfo{Reason: "NO_ACCESS", Domain: "example.com"})
+		return nil, req.Context(), s.Err()
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := NewClient(server.URL, WithHTTPClient(server.Client()))
+
+	t.Run("result", func(t *testing.T) {
+		in, _ := structpb.NewStruct(map[string]interface{}{"name": "foo"})
+		out := &structpb.Struct{}
+		assert.NoError(t, client.Call(context.Background(), "Service.Echo", in, out))
+		assert.True(t, proto.Equal(in, out), "got %v", out)
+	})
+
+	t.Run("metadata", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "user", "alice")
+		var header metadata.MD
+		out := &structpb.Struct{}
+		assert.NoError(t, client.Call(ctx, "Service.Metadata", &structpb.Struct{}, out, grpc.Header(&header)))
+		assert.Equal(t, "alice", out.GetFields()["user"].GetStringValue())
+		assert.Equal(t, []string{"42"}, header.Get("request-id"))
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		out := &structpb.Value{}
+		assert.NoError(t, client.Call(ctx, "Service.Deadline", &structpb.Struct{}, out))
+		assert.InDelta(t, 5, out.GetNumberValue(), 1)
+	})
+
+	for _, spec := range []struct {
+		method string
+		code   codes.Code
+	}{
+		{method: "Service.Fail", code: codes.PermissionDenied},
+		{method: "Service.Unknown", code: codes.Unimplemented},
+	} {
+		t.Run(spec.method, func(t *testing.T) {
+			err := client.Call(context.Background(), spec.method, &structpb.Struct{}, &structpb.Struct{})
+			s, ok := status.FromError(err)
+			if !assert.True(t, ok, "error %v is not a status", err) {
+				return
+			}
+			assert.Equal(t, spec.code, s.Code())
+		})
+	}
+
+	t.Run("details", func(t *testing.T) {
+		err := client.Call(context.Background(), "Service.Fail", &structpb.Struct{}, &structpb.Struct{})
+		s := status.Convert(err)
+		assert.Equal(t, "denied", s.Message())
+		if details := s.Details(); assert.Len(t, details, 1) {
+			info, ok := details[0].(*errdetails.ErrorInfo)
+			if assert.True(t, ok, "detail %T is not an ErrorInfo", details[0]) {
+				assert.Equal(t, "NO_ACCESS", info.GetReason())
+			}
+		}
+	})
+}
+
+func TestClientCallInvalidParams(t *testing.T) {
+	mux := NewServeMux(WithValidator(ValidatorFunc(func(ctx context.Context, req proto.Message) error {
+		return testFieldError{field: "name", reason: "value is required"}
+	})))
+	mux.Register("Service.Hello", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+		var params structpb.Struct
+		if err := marshaller.Unmarshal(rawBody, &params); err != nil {
+			return nil, req.Context(), status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := mux.ValidateRequest(req.Context(), &params); err != nil {
+			return nil, req.Context(), err
+		}
+		return &params, req.Context(), nil
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	err := NewClient(server.URL).Call(context.Background(), "Service.Hello", &structpb.Struct{}, &structpb.Struct{})
+	s := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, s.Code())
+	if details := s.Details(); assert.Len(t, details, 1) {
+		badRequest, ok := details[0].(*errdetails.BadRequest)
+		if assert.True(t, ok, "detail %T is not a BadRequest", details[0]) {
+			assert.Equal(t, "name", badRequest.GetFieldViolations()[0].GetField())
+		}
+	}
+}
+
+func TestClientCallMarshaler(t *testing.T) {
+	marshaler := &contentTypeMarshaler{
+		Marshaler: &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{UseProtoNames: true},
+		},
+		contentType: "application/x-protojson-names",
+	}
+	mux := NewServeMux(WithMIMEMarshaler(marshaler.contentType, marshaler))
+	mux.Register("Service.Field", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+		var field descriptorpb.FieldDescriptorProto
+		if err := marshaller.Unmarshal(rawBody, &field); err != nil {
+			return nil, req.Context(), status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &field, req.Context(), nil
+	})
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		mux.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	in := &descriptorpb.FieldDescriptorProto{TypeName: proto.String("foo")}
+	out := &descriptorpb.FieldDescriptorProto{}
+	client := NewClient(server.URL, WithClientMarshaler(marshaler))
+	assert.NoError(t, client.Call(context.Background(), "Service.Field", in, out))
+	assert.Equal(t, "foo", out.GetTypeName())
+	assert.Equal(t, marshaler.contentType, header.Get("Content-Type"))
+	assert.Equal(t, marshaler.contentType, header.Get("Accept"))
+}
+
+func TestClientCallNotJSONRPC(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := NewClient(server.URL).Call(context.Background(), "Service.Hello", &structpb.Struct{}, &structpb.Struct{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,288 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/yxlimo/go-jsonrpc-gateway/internal/jsonschema"
+)
+
+const (
+	// DiscoverMethod is the OpenRPC service discovery method, answered when
+	// ServeMux is created with WithDiscover.
+	DiscoverMethod = "rpc.discover"
+	// ListMethodsMethod lists the methods registered on ServeMux, answered when
+	// ServeMux is created with WithDiscover.
+	ListMethodsMethod = "rpc.listMethods"
+
+	openrpcVersion = "1.2.6"
+)
+
+// Info describes the API in the OpenRPC document answered to DiscoverMethod.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// openrpcDocument is the subset of an OpenRPC document answered to
+// DiscoverMethod.
+type openrpcDocument struct {
+	OpenRPC    string           `json:"openrpc"`
+	Info       Info             `json:"info"`
+	Methods    []*openrpcMethod `json:"methods"`
+	Components struct {
+		Schemas map[string]*jsonschema.Schema `json:"schemas"`
+	} `json:"components"`
+}
+
+type openrpcMethod struct {
+	Name           string                      `json:"name"`
+	ParamStructure string                      `json:"paramStructure,omitempty"`
+	Params         []*openrpcContentDescriptor `json:"params"`
+	Result         *openrpcContentDescriptor   `json:"result,omitempty"`
+}
+
+type openrpcContentDescriptor struct {
+	Name     string             `json:"name"`
+	Required bool               `json:"required"`
+	Schema   *jsonschema.Schema `json:"schema"`
+}
+
+// discoverHandler answers DiscoverMethod with an OpenRPC document describing
+// every method registered on s, as marshaller encodes their messages.
+func (s *ServeMux) discoverHandler(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+	g := &schemaGenerator{
+		opts:    marshalOptions(marshaller),
+		schemas: make(map[string]*jsonschema.Schema),
+	}
+	doc := &openrpcDocument{
+		OpenRPC: openrpcVersion,
+		Info:    s.info,
+		Methods: make([]*openrpcMethod, 0, len(s.handlers)),
+	}
+	for _, name := range s.methodNames() {
+		method := &openrpcMethod{
+			Name:   name,
+			Params: []*openrpcContentDescriptor{},
+		}
+		if md := s.handlers[name].methodDescriptor(); md != nil {
+			method.ParamStructure = "by-name"
+			method.Params = g.params(md.Input())
+			method.Result = &openrpcContentDescriptor{
+				Name:   string(md.Output().Name()),
+				Schema: g.message(md.Output()),
+			}
+		}
+		doc.Methods = append(doc.Methods, method)
+	}
+	doc.Components.Schemas = g.schemas
+
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return nil, req.Context(), status.Error(codes.Internal, err.Error())
+	}
+	result := &structpb.Struct{}
+	if err := protojson.Unmarshal(raw, result); err != nil {
+		return nil, req.Context(), status.Error(codes.Internal, err.Error())
+	}
+	return result, req.Context(), nil
+}
+
+// listMethodsHandler answers ListMethodsMethod with the name of every method
+// registered on s and the full names of its request and response messages.
+func (s *ServeMux) listMethodsHandler(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+	methods := make([]interface{}, 0, len(s.handlers))
+	for _, name := range s.methodNames() {
+		method := map[string]interface{}{
+			"name": name,
+		}
+		if md := s.handlers[name].methodDescriptor(); md != nil {
+			method["request"] = string(md.Input().FullName())
+			method["response"] = string(md.Output().FullName())
+		}
+		methods = append(methods, method)
+	}
+	list, err := structpb.NewList(methods)
+	if err != nil {
+		return nil, req.Context(), status.Error(codes.Internal, err.Error())
+	}
+	return list, req.Context(), nil
+}
+
+// methodNames returns the sorted names of the registered methods, leaving out
+// the reserved "rpc." methods.
+func (s *ServeMux) methodNames() []string {
+	names := make([]string, 0, len(s.handlers))
+	for name := range s.handlers {
+		if strings.HasPrefix(name, "rpc.") {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// methodDescriptor resolves the gRPC method given with WithGRPCMethod, or
+// returns nil if there is none or it is not linked in the binary.
+func (h *handler) methodDescriptor() protoreflect.MethodDescriptor {
+	if h.grpcMethod == "" {
+		return nil
+	}
+	// "/pkg.Service/Method" is named "pkg.Service.Method" in the registry.
+	// Services without a package are called as "/.Service/Method".
+	name := strings.TrimPrefix(strings.TrimPrefix(h.grpcMethod, "/"), ".")
+	name = strings.ReplaceAll(name, "/", ".")
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil
+	}
+	md, _ := d.(protoreflect.MethodDescriptor)
+	return md
+}
+
+// marshalOptions returns the options marshaller encodes messages with, the
+// protojson defaults unless it is a runtime.JSONPb.
+func marshalOptions(marshaller runtime.Marshaler) protojson.MarshalOptions {
+	switch m := marshaller.(type) {
+	case *runtime.JSONPb:
+		return m.MarshalOptions
+	case *runtime.HTTPBodyMarshaler:
+		return marshalOptions(m.Marshaler)
+	}
+	return protojson.MarshalOptions{}
+}
+
+// schemaGenerator describes messages as encoded with opts, in JSON Schema
+// draft 7 as OpenRPC does.
+type schemaGenerator struct {
+	opts protojson.MarshalOptions
+	// schemas are the messages referenced so far, by full name
+	schemas map[string]*jsonschema.Schema
+}
+
+// params describes the fields of msg as OpenRPC by-name params.
+func (g *schemaGenerator) params(msg protoreflect.MessageDescriptor) []*openrpcContentDescriptor {
+	fields := msg.Fields()
+	params := make([]*openrpcContentDescriptor, 0, fields.Len())
+	if _, ok := jsonschema.Draft07.WellKnownType(msg.FullName()); ok {
+		return params
+	}
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		schema, required := g.field(field)
+		params = append(params, &openrpcContentDescriptor{
+			Name:     g.fieldName(field),
+			Required: required,
+			Schema:   schema,
+		})
+	}
+	return params
+}
+
+// message returns a reference to the schema of msg, adding it and the
+// messages it depends on to g.schemas if needed.
+func (g *schemaGenerator) message(msg protoreflect.MessageDescriptor) *jsonschema.Schema {
+	if wkt, ok := jsonschema.Draft07.WellKnownType(msg.FullName()); ok {
+		return wkt
+	}
+	name := string(msg.FullName())
+	if _, ok := g.schemas[name]; !ok {
+		// register first so that recursive messages terminate
+		schema := &jsonschema.Schema{
+			Title:      string(msg.Name()),
+			Type:       "object",
+			Properties: make(map[string]*jsonschema.Schema),
+		}
+		g.schemas[name] = schema
+		fields := msg.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			property, required := g.field(field)
+			schema.Properties[g.fieldName(field)] = property
+			if required {
+				schema.Required = append(schema.Required, g.fieldName(field))
+			}
+		}
+	}
+	return &jsonschema.Schema{Ref: "#/components/schemas/" + name}
+}
+
+// fieldName returns the property name of field.
+func (g *schemaGenerator) fieldName(field protoreflect.FieldDescriptor) string {
+	if g.opts.UseProtoNames {
+		return string(field.Name())
+	}
+	return field.JSONName()
+}
+
+// field returns the schema of field and whether it is required, a proto2
+// required field or one annotated with google.api.field_behavior REQUIRED.
+func (g *schemaGenerator) field(field protoreflect.FieldDescriptor) (*jsonschema.Schema, bool) {
+	var schema *jsonschema.Schema
+	switch {
+	case field.IsMap():
+		schema = &jsonschema.Schema{
+			Type:                 "object",
+			AdditionalProperties: g.singular(field.MapValue()),
+		}
+	case field.IsList():
+		schema = &jsonschema.Schema{
+			Type:  "array",
+			Items: g.singular(field),
+		}
+	default:
+		schema = g.singular(field)
+	}
+	schema, required := jsonschema.FieldBehavior(field.Options(), schema)
+	return schema, required || field.Cardinality() == protoreflect.Required
+}
+
+// singular returns the schema of a single value of field.
+func (g *schemaGenerator) singular(field protoreflect.FieldDescriptor) *jsonschema.Schema {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		schema := g.message(field.Message())
+		if jsonschema.IsWrapper(field.Message().FullName()) {
+			return jsonschema.Draft07.Nullable(schema)
+		}
+		return schema
+	case protoreflect.EnumKind:
+		return g.enum(field.Enum())
+	}
+	return jsonschema.Scalar(field.Kind())
+}
+
+// enum returns the schema of enum, its value names or numbers depending on
+// g.opts.
+func (g *schemaGenerator) enum(enum protoreflect.EnumDescriptor) *jsonschema.Schema {
+	if wkt, ok := jsonschema.Draft07.WellKnownType(enum.FullName()); ok {
+		return wkt
+	}
+	values := enum.Values()
+	schema := &jsonschema.Schema{Type: "string"}
+	if g.opts.UseEnumNumbers {
+		schema.Type = "integer"
+	}
+	for i := 0; i < values.Len(); i++ {
+		if g.opts.UseEnumNumbers {
+			schema.Enum = append(schema.Enum, values.Get(i).Number())
+		} else {
+			schema.Enum = append(schema.Enum, string(values.Get(i).Name()))
+		}
+	}
+	return schema
+}
@@ -16,7 +16,11 @@ package jsonrpc
 // You should have received a copy of the GNU Lesser General Public License
 // along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
 
-import "fmt"
+import (
+	"fmt"
+
+	"google.golang.org/grpc/status"
+)
 
 // HTTPError is returned by client operations when the HTTP status code of the
 // response is not a 2xx status.
@@ -55,10 +59,23 @@ var (
 	_ Error = new(invalidMessageError)
 	_ Error = new(invalidParamsError)
 	_ Error = new(CustomError)
+	_ Error = new(statusError)
 )
 
 const defaultErrorCode = -32000
 
+// DeadlineExceededErrorCode is the JSON-RPC error code returned when a call
+// does not complete before its deadline.
+const DeadlineExceededErrorCode = -32001
+
+// parseErrorCode is the JSON-RPC error code returned when the request body is
+// not valid JSON.
+const parseErrorCode = -32700
+
+// InvalidParamsErrorCode is the JSON-RPC error code returned when the params of
+// a call fail validation.
+const InvalidParamsErrorCode = -32602
+
 type methodNotFoundError struct{ method string }
 
 func (e *methodNotFoundError) ErrorCode() int { return -32601 }
@@ -78,7 +95,7 @@ func (e *subscriptionNotFoundError) Error() string {
 // Invalid JSON was received by the server.
 type parseError struct{ message string }
 
-func (e *parseError) ErrorCode() int { return -32700 }
+func (e *parseError) ErrorCode() int { return parseErrorCode }
 
 func (e *parseError) Error() string { return e.message }
 
@@ -92,14 +109,14 @@ func (e *invalidRequestError) Error() string { return e.message }
 // received message is invalid
 type invalidMessageError struct{ message string }
 
-func (e *invalidMessageError) ErrorCode() int { return -32700 }
+func (e *invalidMessageError) ErrorCode() int { return parseErrorCode }
 
 func (e *invalidMessageError) Error() string { return e.message }
 
 // unable to decode supplied params, or an invalid number of parameters
 type invalidParamsError struct{ message string }
 
-func (e *invalidParamsError) ErrorCode() int { return -32602 }
+func (e *invalidParamsError) ErrorCode() int { return InvalidParamsErrorCode }
 
 func (e *invalidParamsError) Error() string { return e.message }
 
@@ -111,3 +128,17 @@ type CustomError struct {
 func (e *CustomError) ErrorCode() int { return e.Code }
 
 func (e *CustomError) Error() string { return e.ValidationError }
+
+// statusError is a gRPC status error reported with a JSON-RPC error code other
+// than the one of its gRPC code. The HTTP status of the response still follows
+// the gRPC code.
+type statusError struct {
+	s    *status.Status
+	code int
+}
+
+func (e *statusError) Error() string { return e.s.Message() }
+
+func (e *statusError) ErrorCode() int { return e.code }
+
+func (e *statusError) GRPCStatus() *status.Status { return e.s }
@@ -2,22 +2,26 @@ package jsonrpc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"mime"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
 	maxRequestContentLength = 1024 * 1024 * 5
 	contentType             = "application/json"
+	grpcTimeoutHeader       = "Grpc-Timeout"
 )
 
 // https://www.jsonrpc.org/historical/json-rpc-over-http.html#id13
@@ -47,9 +51,10 @@ func (t *httpServerConn) RemoteAddr() string {
 // SetWriteDeadline does nothing and always returns nil.
 func (t *httpServerConn) SetWriteDeadline(time.Time) error { return nil }
 
-// validateRequest ret[urns a non-zero response code and error message if the
-// request is invalid.]
-func validateRequest(r *http.Request) (int, error) {
+// validateRequest returns a non-zero response code and error message if the
+// request is invalid. Besides the JSON-RPC content types, any MIME type with a
+// marshaler registered in marshalers is accepted.
+func validateRequest(r *http.Request, marshalers marshalerRegistry) (int, error) {
 	if r.Method == http.MethodPut || r.Method == http.MethodDelete || r.Method == http.MethodGet {
 		return http.StatusMethodNotAllowed, errors.New("method not allowed")
 	}
@@ -64,79 +69,201 @@ func validateRequest(r *http.Request) (int, error) {
 				return 0, nil
 			}
 		}
+		if marshalers.has(mt) {
+			return 0, nil
+		}
 	}
 	// Invalid content-type
 	err := fmt.Errorf("invalid content type, only %s is supported", contentType)
 	return http.StatusUnsupportedMediaType, err
 }
 
-func httpErrorHandler(ctx context.Context, mux *ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, req *jsonrpcMessage, err error) {
-	// return Internal when Marshal failed
-	var fallback = &jsonrpcMessage{
-		Version: "2.0",
-		ID:      req.ID,
-		Method:  req.Method,
-		Error: &jsonError{
-			Code:    13,
-			Message: "failed to marshal error message",
-			Data:    nil,
-		},
+// responseWriter writes JSON-RPC response objects with the runtime.Marshaler
+// configured on ServeMux. Results, errors and batches all go through it, so
+// they share the same encoding and Content-Type. Response objects are JSON, so
+// results and error details of a marshaler which does not encode JSON, such as
+// runtime.ProtoMarshaller, are reported as internal errors.
+type responseWriter struct {
+	http.ResponseWriter
+	mux       *ServeMux
+	marshaler runtime.Marshaler
+}
+
+func newResponseWriter(w http.ResponseWriter, mux *ServeMux, marshaler runtime.Marshaler) *responseWriter {
+	return &responseWriter{ResponseWriter: w, mux: mux, marshaler: marshaler}
+}
+
+// resultMessage returns the response object carrying resp as the result of req.
+func (w *responseWriter) resultMessage(req *jsonrpcMessage, resp proto.Message) (*jsonrpcMessage, error) {
+	buf, err := w.marshaler.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(buf) {
+		return nil, status.Errorf(codes.Internal, "marshaler %T does not encode results as JSON", w.marshaler)
 	}
+	return &jsonrpcMessage{
+		Version: vsn,
+		ID:      responseID(req),
+		Method:  w.mux.responseMethod(req),
+		Result:  buf,
+	}, nil
+}
 
+// errorMessage returns the response object describing err as the failure of req.
+func (w *responseWriter) errorMessage(req *jsonrpcMessage, err error) *jsonrpcMessage {
+	s := convertError(err)
+	jerr := &jsonError{
+		Code:    errorCode(s.Code()),
+		Message: s.Message(),
+	}
+	var coded Error
+	if errors.As(err, &coded) {
+		jerr.Code = coded.ErrorCode()
+	}
+	if details := s.Proto().GetDetails(); len(details) > 0 {
+		data := make([]json.RawMessage, 0, len(details))
+		for _, detail := range details {
+			buf, merr := w.marshaler.Marshal(detail)
+			if merr == nil && !json.Valid(buf) {
+				merr = fmt.Errorf("marshaler %T does not encode JSON", w.marshaler)
+			}
+			if merr != nil {
+				grpclog.Infof("Failed to marshal error detail %q: %v", detail.GetTypeUrl(), merr)
+				continue
+			}
+			data = append(data, buf)
+		}
+		if len(data) > 0 {
+			jerr.Data = data
+		}
+	}
+	return &jsonrpcMessage{
+		Version: vsn,
+		ID:      responseID(req),
+		Method:  w.mux.responseMethod(req),
+		Error:   jerr,
+	}
+}
+
+// writeError writes err as the response to req, using the HTTP status
+// corresponding to its gRPC code.
+func (w *responseWriter) writeError(req *jsonrpcMessage, err error) {
+	msg := w.errorMessage(req, err)
+	code := convertError(err).Code()
+
+	st := runtime.HTTPStatusFromCode(code)
 	var customStatus *runtime.HTTPStatusError
 	if errors.As(err, &customStatus) {
-		err = customStatus.Err
+		st = customStatus.HTTPStatus
+	}
+	if code == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", msg.Error.Message)
 	}
+	w.write(st, msg)
+}
 
-	s := status.Convert(err)
-	pb := s.Proto()
+// write marshals v, a single response object or a batch of them, and writes it
+// with the HTTP status st. Response objects whose results and error details are
+// JSON can always be encoded, so if the marshaler fails on v itself, as
+// marshalers of protobuf messages only do, v is encoded as plain JSON instead.
+func (w *responseWriter) write(st int, v interface{}) {
+	mime := w.marshaler.ContentType(v)
+	buf, err := w.marshaler.Marshal(v)
+	if err == nil && !json.Valid(buf) {
+		err = fmt.Errorf("marshaler %T does not encode JSON", w.marshaler)
+	}
+	if err != nil {
+		grpclog.Infof("Failed to marshal response with %T, falling back to JSON: %v", w.marshaler, err)
+		mime = contentType
+		buf, err = json.Marshal(v)
+	}
+	if err != nil {
+		grpclog.Infof("Failed to marshal response: %v", err)
+		// return Internal when Marshal failed
+		st = http.StatusInternalServerError
+		buf, _ = json.Marshal(&jsonrpcMessage{
+			Version: vsn,
+			ID:      null,
+			Error: &jsonError{
+				Code:    int(codes.Internal),
+				Message: "failed to marshal response",
+			},
+		})
+	}
 
 	w.Header().Del("Trailer")
 	w.Header().Del("Transfer-Encoding")
+	w.Header().Set("Content-Type", mime)
 
-	contentType := marshaler.ContentType(pb)
-	w.Header().Set("Content-Type", contentType)
-
-	if s.Code() == codes.Unauthenticated {
-		w.Header().Set("WWW-Authenticate", s.Message())
-	}
-	jsonError := &jsonrpcMessage{
-		Version: "2.0",
-		ID:      req.ID,
-		Method:  req.Method,
-		Error: &jsonError{
-			Code:    int(s.Code()),
-			Message: s.Message(),
-			Data:    s.Details(),
-		},
-	}
-	buf, merr := marshaler.Marshal(jsonError)
-	if merr != nil {
-		grpclog.Infof("Failed to marshal error message %q: %v", s, merr)
-		w.WriteHeader(http.StatusInternalServerError)
-		if err := marshaler.NewEncoder(w).Encode(&fallback); err != nil {
-			grpclog.Infof("Failed to write response: %v", err)
-		}
-		return
+	w.WriteHeader(st)
+	if _, err := w.Write(buf); err != nil {
+		grpclog.Infof("Failed to write response: %v", err)
 	}
+}
 
-	md, ok := runtime.ServerMetadataFromContext(ctx)
-	if !ok {
-		grpclog.Infof("Failed to extract ServerMetadata from context")
+// convertError converts err into a status, mapping context errors to their
+// gRPC codes.
+func convertError(err error) *status.Status {
+	var customStatus *runtime.HTTPStatusError
+	if errors.As(err, &customStatus) {
+		err = customStatus.Err
 	}
+	if s, ok := status.FromError(err); ok {
+		return s
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return status.FromContextError(err)
+	}
+	return status.Convert(err)
+}
 
-	handleForwardResponseServerMetadata(w, md)
-
-	st := runtime.HTTPStatusFromCode(s.Code())
-	if customStatus != nil {
-		st = customStatus.HTTPStatus
+// errorCode returns the JSON-RPC error code for a gRPC code.
+func errorCode(code codes.Code) int {
+	if code == codes.DeadlineExceeded {
+		return DeadlineExceededErrorCode
 	}
+	return int(code)
+}
 
-	w.WriteHeader(st)
-	if _, err := w.Write(buf); err != nil {
-		grpclog.Infof("Failed to write response: %v", err)
+// responseID returns the id a response to req must carry, which is null when
+// the id of req could not be determined.
+func responseID(req *jsonrpcMessage) json.RawMessage {
+	if req.ID == nil {
+		return null
 	}
+	return req.ID
+}
 
+// decodeTimeout decodes a timeout in the format of the Grpc-Timeout header,
+// e.g. "100m" for 100 milliseconds.
+func decodeTimeout(s string) (time.Duration, error) {
+	size := len(s)
+	if size < 2 {
+		return 0, fmt.Errorf("timeout string is too short: %q", s)
+	}
+	var unit time.Duration
+	switch s[size-1] {
+	case 'H':
+		unit = time.Hour
+	case 'M':
+		unit = time.Minute
+	case 'S':
+		unit = time.Second
+	case 'm':
+		unit = time.Millisecond
+	case 'u':
+		unit = time.Microsecond
+	case 'n':
+		unit = time.Nanosecond
+	default:
+		return 0, fmt.Errorf("timeout unit is not recognized: %q", s)
+	}
+	t, err := strconv.ParseInt(s[:size-1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return unit * time.Duration(t), nil
 }
 
 func handleForwardResponseServerMetadata(w http.ResponseWriter, md runtime.ServerMetadata) {
@@ -58,6 +58,9 @@ type jsonrpcMessage struct {
 	Params  json.RawMessage `json:"params,omitempty"`
 	Error   *jsonError      `json:"error,omitempty"`
 	Result  json.RawMessage `json:"result,omitempty"`
+	// Timeout is an extension of the request object which limits how long
+	// the call may take, e.g. "1.5s" or "300ms".
+	Timeout string `json:"timeout,omitempty"`
 }
 
 func (msg *jsonrpcMessage) isNotification() bool {
@@ -202,7 +205,8 @@ func (c *jsonCodec) readBatch() (messages []*jsonrpcMessage, batch bool, err err
 	var rawmsg json.RawMessage
 	if err := c.decode(&rawmsg); err != nil {
 		spew.Dump(err)
-		return nil, false, status.New(codes.InvalidArgument, fmt.Sprintf("decode JSON: %v", err)).Err()
+		st := status.New(codes.InvalidArgument, fmt.Sprintf("decode JSON: %v", err))
+		return nil, false, &statusError{s: st, code: parseErrorCode}
 	}
 	messages, batch = parseMessage(rawmsg)
 	for i, msg := range messages {
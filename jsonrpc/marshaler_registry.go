@@ -0,0 +1,124 @@
+package jsonrpc
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	acceptHeader      = http.CanonicalHeaderKey("Accept")
+	contentTypeHeader = http.CanonicalHeaderKey("Content-Type")
+
+	defaultMarshaler = &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{
+			EmitUnpopulated: true,
+		},
+		UnmarshalOptions: protojson.UnmarshalOptions{
+			DiscardUnknown: true,
+		},
+	}
+)
+
+// MarshalerForRequest returns the inbound/outbound marshalers for this request.
+// The inbound marshaler is the one registered for the MIME type of the
+// Content-Type header, falling back to runtime.MIMEWildcard. The outbound
+// marshaler is the one registered for the MIME type of the Accept header with
+// the highest quality value, the first listed among equals, falling back to the
+// inbound marshaler. MIME types with a quality value of 0 are never selected.
+func MarshalerForRequest(mux *ServeMux, r *http.Request) (inbound runtime.Marshaler, outbound runtime.Marshaler) {
+	quality := 0.0
+	for _, acceptVal := range r.Header[acceptHeader] {
+		for _, mediaRange := range strings.Split(acceptVal, ",") {
+			accept, params, err := mime.ParseMediaType(mediaRange)
+			if err != nil {
+				continue
+			}
+			m, ok := mux.marshalers.mimeMap[accept]
+			if !ok {
+				continue
+			}
+			if q := mediaRangeQuality(params); q > quality {
+				outbound, quality = m, q
+			}
+		}
+	}
+
+	for _, contentTypeVal := range r.Header[contentTypeHeader] {
+		contentType, _, err := mime.ParseMediaType(contentTypeVal)
+		if err != nil {
+			grpclog.Infof("Failed to parse Content-Type %s: %v", contentTypeVal, err)
+			continue
+		}
+		if m, ok := mux.marshalers.mimeMap[contentType]; ok {
+			inbound = m
+			break
+		}
+	}
+
+	if inbound == nil {
+		inbound = mux.marshalers.mimeMap[runtime.MIMEWildcard]
+	}
+	if outbound == nil {
+		outbound = inbound
+	}
+
+	return inbound, outbound
+}
+
+// mediaRangeQuality returns the quality value in the parameters of a media
+// range of the Accept header, 1 if there is none. Invalid values count as 0.
+func mediaRangeQuality(params map[string]string) float64 {
+	v, ok := params["q"]
+	if !ok {
+		return 1
+	}
+	q, err := strconv.ParseFloat(v, 64)
+	if err != nil || q < 0 || q > 1 {
+		return 0
+	}
+	return q
+}
+
+// marshalerRegistry is a mapping from MIME types to Marshalers.
+type marshalerRegistry struct {
+	mimeMap map[string]runtime.Marshaler
+}
+
+// add adds a marshaler for a case-sensitive MIME type string ("*" to match any
+// MIME type).
+func (m marshalerRegistry) add(mime string, marshaler runtime.Marshaler) error {
+	if len(mime) == 0 {
+		return errors.New("empty MIME type")
+	}
+
+	m.mimeMap[mime] = marshaler
+
+	return nil
+}
+
+// has reports whether a marshaler is registered for the exact MIME type.
+func (m marshalerRegistry) has(mime string) bool {
+	_, ok := m.mimeMap[mime]
+	return ok && mime != runtime.MIMEWildcard
+}
+
+// makeMarshalerMIMERegistry returns a new registry of marshalers.
+// It allows for a mapping of case-sensitive Content-Type MIME type string to runtime.Marshaler interfaces.
+//
+// For example, a client could ask for protojson with the original proto field
+// names by sending "Accept: application/x-protojson-names", while every other
+// client keeps getting the default marshaler registered for "*".
+func makeMarshalerMIMERegistry() marshalerRegistry {
+	return marshalerRegistry{
+		mimeMap: map[string]runtime.Marshaler{
+			runtime.MIMEWildcard: defaultMarshaler,
+		},
+	}
+}
@@ -4,11 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 // ServerCodec implements reading, parsing and writing RPC messages for the server side of
@@ -29,30 +30,37 @@ type jsonWriter interface {
 	closed() <-chan interface{}
 }
 
-type HandleFunc func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error)
+// HandleFunc handles a JSON-RPC call whose params are rawBody. marshaller must be
+// used to decode rawBody; the returned message is marshaled by ServeMux.
+type HandleFunc func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error)
 
 type ServeMux struct {
 	mux        *runtime.ServeMux
-	marshaller runtime.Marshaler
-	handlers   map[string]HandleFunc
+	marshalers marshalerRegistry
+	handlers   map[string]*handler
+	maxTimeout time.Duration
+	discover   bool
+	info       Info
+	validator  Validator
+
+	// methodInResponse echoes the request method in responses. It is not
+	// part of the JSON-RPC 2.0 response object and is only useful for debugging.
+	methodInResponse bool
 }
 
 func NewServeMux(opts ...ServeMuxOption) *ServeMux {
 	mux := &ServeMux{
-		mux: runtime.NewServeMux(),
-		marshaller: &runtime.JSONPb{
-			MarshalOptions: protojson.MarshalOptions{
-				EmitUnpopulated: true,
-			},
-			UnmarshalOptions: protojson.UnmarshalOptions{
-				DiscardUnknown: true,
-			},
-		},
-		handlers: make(map[string]HandleFunc),
+		mux:        runtime.NewServeMux(),
+		marshalers: makeMarshalerMIMERegistry(),
+		handlers:   make(map[string]*handler),
 	}
 	for _, opt := range opts {
 		opt(mux)
 	}
+	if mux.discover {
+		mux.Register(DiscoverMethod, mux.discoverHandler)
+		mux.Register(ListMethodsMethod, mux.listMethodsHandler)
+	}
 	return mux
 }
 
@@ -60,48 +68,161 @@ func (s *ServeMux) RuntimeMux() *runtime.ServeMux {
 	return s.mux
 }
 
-func (s *ServeMux) Register(method string, handler HandleFunc) {
+// handler is a HandleFunc registered on ServeMux with its options.
+type handler struct {
+	fn             HandleFunc
+	defaultTimeout time.Duration
+	// grpcMethod is the full name of the gRPC method behind fn, as in
+	// "/pkg.Service/Method".
+	grpcMethod string
+}
+
+func (s *ServeMux) Register(method string, fn HandleFunc, opts ...HandlerOption) {
 	if _, ok := s.handlers[method]; ok {
 		panic("duplicate handler for " + method)
 	}
-	s.handlers[method] = handler
+	h := &handler{fn: fn}
+	for _, opt := range opts {
+		opt(h)
+	}
+	s.handlers[method] = h
 }
 
 func (s *ServeMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if code, err := validateRequest(r); err != nil {
+	if code, err := validateRequest(r, s.marshalers); err != nil {
 		http.Error(w, err.Error(), code)
 		return
 	}
-	codec := NewHTTPServerConn(r, w, s.marshaller)
-	msg, isBatch, err := codec.readBatch()
+	inbound, outbound := MarshalerForRequest(s, r)
+	codec := NewHTTPServerConn(r, w, inbound)
+	rw := newResponseWriter(w, s, outbound)
+	msgs, isBatch, err := codec.readBatch()
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		// the id of the request cannot be known, so the response has a null id
+		rw.writeError(&jsonrpcMessage{}, err)
 		return
 	}
 	if isBatch {
-		http.Error(w, "batch request not supported", http.StatusBadRequest)
+		s.serveBatch(rw, r, inbound, msgs)
 		return
 	}
-	h, ok := s.handlers[msg[0].Method]
-	if !ok {
-		httpErrorHandler(r.Context(), s, s.marshaller, w, msg[0], status.New(codes.Unimplemented, "method not implemented").Err())
+	msg := msgs[0]
+	ctx, resp, err := s.handle(r, inbound, msg)
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		handleForwardResponseServerMetadata(w, md)
+	}
+	if msg.isNotification() {
+		// the call is made but, as in a batch, a notification gets no response
+		rw.WriteHeader(http.StatusNoContent)
 		return
 	}
-	resp, newCtx, err := h(r, s.marshaller, msg[0].Params)
 	if err != nil {
-		httpErrorHandler(newCtx, s, s.marshaller, w, msg[0], err)
+		rw.writeError(msg, err)
 		return
 	}
-	byes, err := s.marshaller.Marshal(resp)
+	reply, err := rw.resultMessage(msg, resp)
 	if err != nil {
-		httpErrorHandler(newCtx, s, s.marshaller, w, msg[0], err)
+		rw.writeError(msg, err)
 		return
 	}
-	w.Header().Set("Content-Type", "application/json")
-	_ = codec.writeJSON(newCtx, &jsonrpcMessage{
-		Version: "2.0",
-		ID:      msg[0].ID,
-		Method:  msg[0].Method,
-		Result:  byes,
-	})
+	rw.write(http.StatusOK, reply)
+}
+
+// serveBatch handles every call in msgs and writes their responses as a single
+// batch. Notifications are handled but get no response.
+func (s *ServeMux) serveBatch(rw *responseWriter, r *http.Request, inbound runtime.Marshaler, msgs []*jsonrpcMessage) {
+	if len(msgs) == 0 {
+		rw.writeError(&jsonrpcMessage{}, status.Error(codes.InvalidArgument, "empty batch"))
+		return
+	}
+	replies := make([]*jsonrpcMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		ctx, resp, err := s.handle(r, inbound, msg)
+		// the headers of every call are merged into those of the response
+		if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+			handleForwardResponseServerMetadata(rw, md)
+		}
+		var reply *jsonrpcMessage
+		if err == nil {
+			reply, err = rw.resultMessage(msg, resp)
+		}
+		if err != nil {
+			reply = rw.errorMessage(msg, err)
+		}
+		if msg.isNotification() {
+			continue
+		}
+		replies = append(replies, reply)
+	}
+	if len(replies) == 0 {
+		rw.WriteHeader(http.StatusNoContent)
+		return
+	}
+	rw.write(http.StatusOK, replies)
+}
+
+// handle dispatches msg to the handler registered for its method, which decodes
+// the params with inbound.
+func (s *ServeMux) handle(r *http.Request, inbound runtime.Marshaler, msg *jsonrpcMessage) (context.Context, proto.Message, error) {
+	h, ok := s.handlers[msg.Method]
+	if !ok {
+		return r.Context(), nil, status.New(codes.Unimplemented, "method not implemented").Err()
+	}
+	timeout, err := s.callTimeout(r, h, msg)
+	if err != nil {
+		return r.Context(), nil, err
+	}
+	if timeout > 0 {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		r = r.WithContext(ctx)
+	}
+	if r.Header.Get(grpcTimeoutHeader) != "" {
+		// the header is accounted for in timeout, which runtime.AnnotateContext
+		// in generated handlers would otherwise cut to it again
+		r = r.WithContext(r.Context())
+		r.Header = r.Header.Clone()
+		r.Header.Del(grpcTimeoutHeader)
+	}
+	resp, ctx, err := h.fn(r, inbound, msg.Params)
+	if ctx == nil {
+		ctx = r.Context()
+	}
+	return ctx, resp, err
+}
+
+// callTimeout returns the timeout of msg. A timeout asked by the client, in the
+// "timeout" member of msg or else the Grpc-Timeout header, takes precedence over
+// the default of h. Either way it is capped by the maximum timeout of the mux.
+// Zero means no timeout; a client cannot ask for it, so a timeout that is not
+// positive is rejected.
+func (s *ServeMux) callTimeout(r *http.Request, h *handler, msg *jsonrpcMessage) (time.Duration, error) {
+	timeout := h.defaultTimeout
+	if tm := r.Header.Get(grpcTimeoutHeader); tm != "" {
+		d, err := decodeTimeout(tm)
+		if err != nil || d <= 0 {
+			return 0, status.Errorf(codes.InvalidArgument, "invalid grpc-timeout: %s", tm)
+		}
+		timeout = d
+	}
+	if msg.Timeout != "" {
+		d, err := time.ParseDuration(msg.Timeout)
+		if err != nil || d <= 0 {
+			return 0, status.Errorf(codes.InvalidArgument, "invalid timeout: %s", msg.Timeout)
+		}
+		timeout = d
+	}
+	if s.maxTimeout > 0 && (timeout <= 0 || timeout > s.maxTimeout) {
+		timeout = s.maxTimeout
+	}
+	return timeout, nil
+}
+
+// responseMethod returns the method to be echoed in the response of req,
+// which is empty unless WithMethodInResponse is given.
+func (s *ServeMux) responseMethod(req *jsonrpcMessage) string {
+	if !s.methodInResponse {
+		return ""
+	}
+	return req.Method
 }
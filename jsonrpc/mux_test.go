@@ -9,11 +9,35 @@ import (
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	_ "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
+// echoHandler returns its params as the result.
+func echoHandler(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+	var params structpb.Struct
+	if err := marshaller.Unmarshal(rawBody, &params); err != nil {
+		return nil, req.Context(), status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &params, req.Context(), nil
+}
+
 func TestMuxServeHTTP(t *testing.T) {
 	for i, spec := range []struct {
 		reqMethod  string
@@ -21,6 +45,7 @@ func TestMuxServeHTTP(t *testing.T) {
 		reqContent map[string]interface{}
 		headers    map[string]string
 		jrpcMethod string
+		opts       []ServeMuxOption
 
 		respStatus  int
 		respContent map[string]interface{}
@@ -90,6 +115,31 @@ func TestMuxServeHTTP(t *testing.T) {
 				"Content-Type": "application/json",
 			},
 			respStatus: http.StatusOK,
+			respContent: map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      "1",
+				"result": map[string]interface{}{
+					"name": "world",
+				},
+			},
+		},
+		{
+			reqMethod:  "POST",
+			reqPath:    "/",
+			jrpcMethod: "Service.Hello",
+			opts:       []ServeMuxOption{WithMethodInResponse()},
+			reqContent: map[string]interface{}{
+				"jsonrpc": "2.0",
+				"method":  "Service.Hello",
+				"id":      "1",
+				"params": map[string]string{
+					"name": "world",
+				},
+			},
+			headers: map[string]string{
+				"Content-Type": "application/json",
+			},
+			respStatus: http.StatusOK,
 			respContent: map[string]interface{}{
 				"jsonrpc": "2.0",
 				"method":  "Service.Hello",
@@ -99,14 +149,32 @@ func TestMuxServeHTTP(t *testing.T) {
 				},
 			},
 		},
+		{
+			reqMethod:  "POST",
+			reqPath:    "/",
+			jrpcMethod: "Service.Hello",
+			reqContent: map[string]interface{}{
+				"jsonrpc": "2.0",
+				"method":  "Service.Greet",
+				"id":      "1",
+			},
+			headers: map[string]string{
+				"Content-Type": "application/json",
+			},
+			respStatus: http.StatusNotImplemented,
+			respContent: map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      "1",
+				"error": map[string]interface{}{
+					"code":    float64(12),
+					"message": "method not implemented",
+				},
+			},
+		},
 	} {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			mux := NewServeMux()
-			func(jrpcMethod string) {
-				mux.Register(jrpcMethod, func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
-					return rawBody, req.Context(), nil
-				})
-			}(spec.jrpcMethod)
+			mux := NewServeMux(spec.opts...)
+			mux.Register(spec.jrpcMethod, echoHandler)
 
 			reqUrl := fmt.Sprintf("https://host.example%s", spec.reqPath)
 			reqReader := bytes.NewReader(nil)
@@ -136,3 +204,790 @@ func TestMuxServeHTTP(t *testing.T) {
 		})
 	}
 }
+
+func TestMuxServeHTTPBatch(t *testing.T) {
+	mux := NewServeMux()
+	mux.Register("Service.Hello", echoHandler)
+
+	body := `[
+		{"jsonrpc": "2.0", "method": "Service.Hello", "id": 1, "params": {"name": "world"}},
+		{"jsonrpc": "2.0", "method": "Service.Hello", "params": {"name": "notification"}},
+		{"jsonrpc": "2.0", "method": "Service.Greet", "id": 2},
+		{"foo": "bar"}
+	]`
+	r := httptest.NewRequest("POST", "https://host.example/", bytes.NewReader([]byte(body)))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	var got []map[string]interface{}
+	assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"jsonrpc": "2.0",
+			"id":      float64(1),
+			"result":  map[string]interface{}{"name": "world"},
+		},
+		{
+			"jsonrpc": "2.0",
+			"id":      float64(2),
+			"error":   map[string]interface{}{"code": float64(12), "message": "method not implemented"},
+		},
+		{
+			"jsonrpc": "2.0",
+			"id":      nil,
+			"error":   map[string]interface{}{"code": float64(12), "message": "method not implemented"},
+		},
+	}, got)
+}
+
+func TestMuxServeHTTPBatchNotifications(t *testing.T) {
+	mux := NewServeMux()
+	mux.Register("Service.Hello", echoHandler)
+
+	body := `[{"jsonrpc": "2.0", "method": "Service.Hello", "params": {}}]`
+	r := httptest.NewRequest("POST", "https://host.example/", bytes.NewReader([]byte(body)))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Empty(t, w.Body.Bytes())
+}
+
+func TestMuxServeHTTPBatchMetadata(t *testing.T) {
+	mux := NewServeMux()
+	mux.Register("Service.Hello", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+		var params structpb.Struct
+		if err := marshaller.Unmarshal(rawBody, &params); err != nil {
+			return nil, req.Context(), status.Error(codes.InvalidArgument, err.Error())
+		}
+		md := metadata.Pairs("name", params.GetFields()["name"].GetStringValue())
+		ctx := runtime.NewServerMetadataContext(req.Context(), runtime.ServerMetadata{HeaderMD: md})
+		return &params, ctx, nil
+	})
+
+	body := `[
+		{"jsonrpc": "2.0", "method": "Service.Hello", "id": 1, "params": {"name": "foo"}},
+		{"jsonrpc": "2.0", "method": "Service.Hello", "params": {"name": "bar"}}
+	]`
+	r := httptest.NewRequest("POST", "https://host.example/", bytes.NewReader([]byte(body)))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"foo", "bar"}, w.Header().Values(runtime.MetadataHeaderPrefix+"name"))
+}
+
+func TestMuxServeHTTPNotification(t *testing.T) {
+	mux := NewServeMux()
+	called := false
+	mux.Register("Service.Hello", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+		called = true
+		return echoHandler(req, marshaller, rawBody)
+	})
+
+	body := `{"jsonrpc": "2.0", "method": "Service.Hello", "params": {}}`
+	r := httptest.NewRequest("POST", "https://host.example/", bytes.NewReader([]byte(body)))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	assert.True(t, called)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Empty(t, w.Body.Bytes())
+}
+
+func TestMuxServeHTTPParseError(t *testing.T) {
+	mux := NewServeMux()
+	mux.Register("Service.Hello", echoHandler)
+
+	body := `{"jsonrpc": "2.0", "method": "Service.Hello", "id": 1, "params": {`
+	r := httptest.NewRequest("POST", "https://host.example/", bytes.NewReader([]byte(body)))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	var got map[string]interface{}
+	assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	assert.Contains(t, got, "id")
+	assert.Nil(t, got["id"])
+	assert.Equal(t, float64(-32700), got["error"].(map[string]interface{})["code"])
+}
+
+// contentTypeMarshaler overrides the content type of the wrapped marshaler.
+type contentTypeMarshaler struct {
+	runtime.Marshaler
+	contentType string
+}
+
+func (m *contentTypeMarshaler) ContentType(interface{}) string {
+	return m.contentType
+}
+
+func TestMuxServeHTTPMarshaler(t *testing.T) {
+	marshaler := &contentTypeMarshaler{
+		Marshaler: &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{UseProtoNames: true},
+		},
+		contentType: "application/x-test+json",
+	}
+	mux := NewServeMux(WithMarshalerOption(marshaler))
+	mux.Register("Service.Hello", echoHandler)
+	mux.Register("Service.Fail", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+		st, _ := status.New(codes.InvalidArgument, "bad request").WithDetails(&errdetails.ErrorInfo{Reason: "BAD_NAME"})
+		return nil, req.Context(), st.Err()
+	})
+
+	for _, spec := range []struct {
+		body        string
+		respStatus  int
+		respContent map[string]interface{}
+	}{
+		{
+			body:       `{"jsonrpc": "2.0", "method": "Service.Hello", "id": "1", "params": {"name": "world"}}`,
+			respStatus: http.StatusOK,
+			respContent: map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      "1",
+				"result":  map[string]interface{}{"name": "world"},
+			},
+		},
+		{
+			body:       `{"jsonrpc": "2.0", "method": "Service.Fail", "id": "1", "params": {}}`,
+			respStatus: http.StatusBadRequest,
+			respContent: map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      "1",
+				"error": map[string]interface{}{
+					"code":    float64(3),
+					"message": "bad request",
+					"data": []interface{}{
+						map[string]interface{}{
+							"@type":  "type.googleapis.com/google.rpc.ErrorInfo",
+							"reason": "BAD_NAME",
+						},
+					},
+				},
+			},
+		},
+	} {
+		r := httptest.NewRequest("POST", "https://host.example/", bytes.NewReader([]byte(spec.body)))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+
+		assert.Equal(t, spec.respStatus, w.Code)
+		assert.Equal(t, "application/x-test+json", w.Header().Get("Content-Type"))
+		got := map[string]interface{}{}
+		assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+		assert.Equal(t, spec.respContent, got)
+	}
+}
+
+func TestMuxServeHTTPMarshalerNotJSON(t *testing.T) {
+	mux := NewServeMux(WithMIMEMarshaler("application/x-protobuf", &runtime.ProtoMarshaller{}))
+	mux.Register("Service.Hello", echoHandler)
+	mux.Register("Service.Fail", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+		st, _ := status.New(codes.InvalidArgument, "bad request").WithDetails(&errdetails.ErrorInfo{Reason: "BAD_NAME"})
+		return nil, req.Context(), st.Err()
+	})
+
+	for i, spec := range []struct {
+		body        string
+		respStatus  int
+		respContent map[string]interface{}
+	}{
+		{
+			body:       `{"jsonrpc": "2.0", "method": "Service.Hello", "id": "1", "params": {"name": "world"}}`,
+			respStatus: http.StatusInternalServerError,
+			respContent: map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      "1",
+				"error": map[string]interface{}{
+					"code":    float64(13),
+					"message": "marshaler *runtime.ProtoMarshaller does not encode results as JSON",
+				},
+			},
+		},
+		{
+			// the details cannot be encoded as JSON and are left out
+			body:       `{"jsonrpc": "2.0", "method": "Service.Fail", "id": "1", "params": {}}`,
+			respStatus: http.StatusBadRequest,
+			respContent: map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      "1",
+				"error":   map[string]interface{}{"code": float64(3), "message": "bad request"},
+			},
+		},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			r := httptest.NewRequest("POST", "https://host.example/", bytes.NewReader([]byte(spec.body)))
+			r.Header.Set("Content-Type", "application/json")
+			r.Header.Set("Accept", "application/x-protobuf")
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+
+			assert.Equal(t, spec.respStatus, w.Code)
+			assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+			got := map[string]interface{}{}
+			assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+			assert.Equal(t, spec.respContent, got)
+		})
+	}
+}
+
+func TestMuxServeHTTPMarshalerForRequest(t *testing.T) {
+	protoNames := &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{UseProtoNames: true},
+	}
+	mux := NewServeMux(
+		WithMIMEMarshaler("application/x-protojson-names", protoNames),
+		WithMIMEMarshaler("application/x-protojson", &runtime.JSONPb{}),
+	)
+	mux.Register("Service.Field", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+		var field descriptorpb.FieldDescriptorProto
+		if err := marshaller.Unmarshal(rawBody, &field); err != nil {
+			return nil, req.Context(), status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &field, req.Context(), nil
+	})
+
+	for i, spec := range []struct {
+		headers    map[string]string
+		params     string
+		respStatus int
+		resultKey  string
+	}{
+		{
+			headers:    map[string]string{"Content-Type": "application/json"},
+			params:     `{"typeName": "foo"}`,
+			respStatus: http.StatusOK,
+			resultKey:  "typeName",
+		},
+		{
+			headers: map[string]string{
+				"Content-Type": "application/json",
+				"Accept":       "text/html, application/x-protojson-names;q=0.9",
+			},
+			params:     `{"typeName": "foo"}`,
+			respStatus: http.StatusOK,
+			resultKey:  "type_name",
+		},
+		{
+			headers: map[string]string{
+				"Content-Type": "application/json",
+				"Accept":       "application/x-protojson-names;q=0",
+			},
+			params:     `{"typeName": "foo"}`,
+			respStatus: http.StatusOK,
+			resultKey:  "typeName",
+		},
+		{
+			headers: map[string]string{
+				"Content-Type": "application/json",
+				"Accept":       "application/x-protojson;q=0.5, application/x-protojson-names;q=0.8",
+			},
+			params:     `{"typeName": "foo"}`,
+			respStatus: http.StatusOK,
+			resultKey:  "type_name",
+		},
+		{
+			headers: map[string]string{
+				"Content-Type": "application/json",
+				"Accept":       "application/x-protojson-names;q=0.2, application/x-protojson",
+			},
+			params:     `{"typeName": "foo"}`,
+			respStatus: http.StatusOK,
+			resultKey:  "typeName",
+		},
+		{
+			headers:    map[string]string{"Content-Type": "application/x-protojson-names"},
+			params:     `{"type_name": "foo"}`,
+			respStatus: http.StatusOK,
+			resultKey:  "type_name",
+		},
+		{
+			headers:    map[string]string{"Content-Type": "application/x-unknown"},
+			params:     `{"type_name": "foo"}`,
+			respStatus: http.StatusUnsupportedMediaType,
+		},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			body := `{"jsonrpc": "2.0", "method": "Service.Field", "id": "1", "params": ` + spec.params + `}`
+			r := httptest.NewRequest("POST", "https://host.example/", bytes.NewReader([]byte(body)))
+			for name, value := range spec.headers {
+				r.Header.Set(name, value)
+			}
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+
+			assert.Equal(t, spec.respStatus, w.Code)
+			if spec.resultKey == "" {
+				return
+			}
+			var got struct {
+				Result map[string]interface{} `json:"result"`
+			}
+			assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+			assert.Equal(t, "foo", got.Result[spec.resultKey])
+		})
+	}
+}
+
+func TestMuxServeHTTPTimeout(t *testing.T) {
+	for i, spec := range []struct {
+		opts           []ServeMuxOption
+		defaultTimeout time.Duration
+		header         string
+		timeout        string
+
+		respStatus int
+		want       time.Duration
+	}{
+		{
+			respStatus: http.StatusOK,
+		},
+		{
+			defaultTimeout: 5 * time.Second,
+			respStatus:     http.StatusOK,
+			want:           5 * time.Second,
+		},
+		{
+			defaultTimeout: 5 * time.Second,
+			header:         "100m",
+			respStatus:     http.StatusOK,
+			want:           100 * time.Millisecond,
+		},
+		{
+			defaultTimeout: 5 * time.Second,
+			header:         "100m",
+			timeout:        "2s",
+			respStatus:     http.StatusOK,
+			want:           2 * time.Second,
+		},
+		{
+			opts:       []ServeMuxOption{WithMaxTimeout(time.Second)},
+			timeout:    "2s",
+			respStatus: http.StatusOK,
+			want:       time.Second,
+		},
+		{
+			opts:       []ServeMuxOption{WithMaxTimeout(time.Second)},
+			respStatus: http.StatusOK,
+			want:       time.Second,
+		},
+		{
+			timeout:    "2 seconds",
+			respStatus: http.StatusBadRequest,
+		},
+		{
+			header:     "2x",
+			respStatus: http.StatusBadRequest,
+		},
+		{
+			opts:       []ServeMuxOption{WithMaxTimeout(time.Second)},
+			header:     "0S",
+			respStatus: http.StatusBadRequest,
+		},
+		{
+			header:     "-5S",
+			respStatus: http.StatusBadRequest,
+		},
+		{
+			timeout:    "-5s",
+			respStatus: http.StatusBadRequest,
+		},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			var (
+				deadline    time.Time
+				hasDeadline bool
+			)
+			mux := NewServeMux(spec.opts...)
+			mux.Register("Service.Hello", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+				deadline, hasDeadline = req.Context().Deadline()
+				return &structpb.Struct{}, req.Context(), nil
+			}, WithDefaultTimeout(spec.defaultTimeout))
+
+			msg := map[string]interface{}{"jsonrpc": "2.0", "method": "Service.Hello", "id": "1", "params": map[string]interface{}{}}
+			if spec.timeout != "" {
+				msg["timeout"] = spec.timeout
+			}
+			raw, _ := json.Marshal(msg)
+			r := httptest.NewRequest("POST", "https://host.example/", bytes.NewReader(raw))
+			r.Header.Set("Content-Type", "application/json")
+			if spec.header != "" {
+				r.Header.Set("Grpc-Timeout", spec.header)
+			}
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+
+			assert.Equal(t, spec.respStatus, w.Code)
+			if spec.respStatus != http.StatusOK {
+				return
+			}
+			if spec.want == 0 {
+				assert.False(t, hasDeadline)
+				return
+			}
+			assert.True(t, hasDeadline)
+			remaining := time.Until(deadline)
+			assert.True(t, remaining <= spec.want && remaining > spec.want-time.Second, "remaining %v, want %v", remaining, spec.want)
+		})
+	}
+}
+
+func TestMuxServeHTTPDeadlineExceeded(t *testing.T) {
+	mux := NewServeMux()
+	mux.Register("Service.Hello", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+		<-req.Context().Done()
+		return nil, req.Context(), req.Context().Err()
+	})
+
+	body := `{"jsonrpc": "2.0", "method": "Service.Hello", "id": "1", "params": {}, "timeout": "10ms"}`
+	r := httptest.NewRequest("POST", "https://host.example/", bytes.NewReader([]byte(body)))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+	var got struct {
+		Error jsonError `json:"error"`
+	}
+	assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	assert.Equal(t, DeadlineExceededErrorCode, got.Error.Code)
+}
+
+func TestMuxServeHTTPDiscover(t *testing.T) {
+	mux := NewServeMux(WithDiscover(Info{Title: "Health", Version: "1.0.0"}))
+	mux.Register("Check", echoHandler, WithGRPCMethod("/grpc.health.v1.Health/Check"))
+	mux.Register("Service.Hello", echoHandler)
+
+	body := `[
+		{"jsonrpc": "2.0", "method": "rpc.listMethods", "id": 1},
+		{"jsonrpc": "2.0", "method": "rpc.discover", "id": 2}
+	]`
+	r := httptest.NewRequest("POST", "https://host.example/", bytes.NewReader([]byte(body)))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var got []struct {
+		Result json.RawMessage `json:"result"`
+	}
+	assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+	assert.Len(t, got, 2)
+	assert.JSONEq(t, `[
+		{"name": "Check", "request": "grpc.health.v1.HealthCheckRequest", "response": "grpc.health.v1.HealthCheckResponse"},
+		{"name": "Service.Hello"}
+	]`, string(got[0].Result))
+
+	var doc struct {
+		Info    Info `json:"info"`
+		Methods []struct {
+			Name   string                   `json:"name"`
+			Params []map[string]interface{} `json:"params"`
+			Result map[string]interface{}   `json:"result"`
+		} `json:"methods"`
+		Components struct {
+			Schemas map[string]interface{} `json:"schemas"`
+		} `json:"components"`
+	}
+	assert.NoError(t, json.Unmarshal(got[1].Result, &doc))
+	assert.Equal(t, Info{Title: "Health", Version: "1.0.0"}, doc.Info)
+	assert.Len(t, doc.Methods, 2)
+	assert.Equal(t, "Check", doc.Methods[0].Name)
+	assert.Equal(t, []map[string]interface{}{
+		{"name": "service", "required": false, "schema": map[string]interface{}{"type": "string"}},
+	}, doc.Methods[0].Params)
+	assert.Equal(t, map[string]interface{}{
+		"$ref": "#/components/schemas/grpc.health.v1.HealthCheckResponse",
+	}, doc.Methods[0].Result["schema"])
+	assert.Contains(t, doc.Components.Schemas, "grpc.health.v1.HealthCheckResponse")
+	assert.Equal(t, "Service.Hello", doc.Methods[1].Name)
+	assert.Empty(t, doc.Methods[1].Params)
+}
+
+// fieldBehavior returns field options annotated with behaviors.
+func fieldBehavior(behaviors ...annotations.FieldBehavior) *descriptorpb.FieldOptions {
+	opts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(opts, annotations.E_FieldBehavior, behaviors)
+	return opts
+}
+
+// pingFile declares a service without a package, whose methods are called as
+// "/.Pinger/Ping", so that it can be discovered.
+var pingFile = func() protoreflect.FileDescriptor {
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:   proto.String("jsonrpc/ping_test.proto"),
+		Syntax: proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Ping"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     proto.String("display_name"),
+					JsonName: proto.String("displayName"),
+					Number:   proto.Int32(1),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					Options:  fieldBehavior(annotations.FieldBehavior_REQUIRED),
+				},
+				{
+					Name:     proto.String("state"),
+					JsonName: proto.String("state"),
+					Number:   proto.Int32(2),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_ENUM.Enum(),
+					TypeName: proto.String(".State"),
+					Options:  fieldBehavior(annotations.FieldBehavior_OUTPUT_ONLY),
+				},
+			},
+		}},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("State"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("STATE_UNSPECIFIED"), Number: proto.Int32(0)},
+				{Name: proto.String("STATE_UP"), Number: proto.Int32(1)},
+			},
+		}},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("Pinger"),
+			Method: []*descriptorpb.MethodDescriptorProto{{
+				Name:       proto.String("Ping"),
+				InputType:  proto.String(".Ping"),
+				OutputType: proto.String(".Ping"),
+			}},
+		}},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		panic(err)
+	}
+	if err := protoregistry.GlobalFiles.RegisterFile(file); err != nil {
+		panic(err)
+	}
+	return file
+}()
+
+func TestMuxServeHTTPDiscoverMarshalOptions(t *testing.T) {
+	for i, spec := range []struct {
+		opts protojson.MarshalOptions
+
+		wantParams string
+		wantSchema string
+	}{
+		{
+			wantParams: `[
+				{"name": "displayName", "required": true, "schema": {"type": "string"}},
+				{"name": "state", "required": false, "schema": {"type": "string", "enum": ["STATE_UNSPECIFIED", "STATE_UP"], "readOnly": true}}
+			]`,
+			wantSchema: `{
+				"title": "Ping",
+				"type": "object",
+				"properties": {
+					"displayName": {"type": "string"},
+					"state": {"type": "string", "enum": ["STATE_UNSPECIFIED", "STATE_UP"], "readOnly": true}
+				},
+				"required": ["displayName"]
+			}`,
+		},
+		{
+			opts: protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true},
+			wantParams: `[
+				{"name": "display_name", "required": true, "schema": {"type": "string"}},
+				{"name": "state", "required": false, "schema": {"type": "integer", "enum": [0, 1], "readOnly": true}}
+			]`,
+			wantSchema: `{
+				"title": "Ping",
+				"type": "object",
+				"properties": {
+					"display_name": {"type": "string"},
+					"state": {"type": "integer", "enum": [0, 1], "readOnly": true}
+				},
+				"required": ["display_name"]
+			}`,
+		},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			mux := NewServeMux(
+				WithDiscover(Info{Title: "Pinger", Version: "1.0.0"}),
+				WithMarshalerOption(&runtime.JSONPb{MarshalOptions: spec.opts}),
+			)
+			mux.Register("Pinger.Ping", echoHandler, WithGRPCMethod("/.Pinger/Ping"))
+
+			body := `{"jsonrpc": "2.0", "method": "rpc.discover", "id": 1}`
+			r := httptest.NewRequest("POST", "https://host.example/", bytes.NewReader([]byte(body)))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			var got struct {
+				Result struct {
+					Methods []struct {
+						Params json.RawMessage `json:"params"`
+					} `json:"methods"`
+					Components struct {
+						Schemas map[string]json.RawMessage `json:"schemas"`
+					} `json:"components"`
+				} `json:"result"`
+			}
+			assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+			if assert.Len(t, got.Result.Methods, 1) {
+				assert.JSONEq(t, spec.wantParams, string(got.Result.Methods[0].Params))
+			}
+			assert.JSONEq(t, spec.wantSchema, string(got.Result.Components.Schemas[string(pingFile.Messages().Get(0).FullName())]))
+		})
+	}
+}
+
+// validatedStruct mimics the methods generated by protoc-gen-validate.
+type validatedStruct struct {
+	*structpb.Struct
+}
+
+type testFieldError struct {
+	field  string
+	reason string
+	cause  error
+}
+
+func (e testFieldError) Error() string  { return e.field + ": " + e.reason }
+func (e testFieldError) Field() string  { return e.field }
+func (e testFieldError) Reason() string { return e.reason }
+func (e testFieldError) Cause() error   { return e.cause }
+
+type testMultiError []error
+
+func (e testMultiError) Error() string      { return fmt.Sprintf("%d errors", len(e)) }
+func (e testMultiError) AllErrors() []error { return e }
+
+func (m validatedStruct) ValidateAll() error {
+	var errs testMultiError
+	if _, ok := m.Fields["name"]; !ok {
+		errs = append(errs, testFieldError{field: "name", reason: "value is required"})
+	}
+	if _, ok := m.Fields["parent"]; ok {
+		errs = append(errs, testFieldError{
+			field:  "parent",
+			reason: "embedded message failed validation",
+			cause:  testFieldError{field: "id", reason: "value must be greater than 0"},
+		})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func TestMuxServeHTTPValidation(t *testing.T) {
+	for i, spec := range []struct {
+		opts   []ServeMuxOption
+		params string
+
+		respStatus int
+		wantCode   int
+		want       []*errdetails.BadRequest_FieldViolation
+	}{
+		{
+			params:     `{}`,
+			respStatus: http.StatusOK,
+		},
+		{
+			opts:       []ServeMuxOption{WithRequestValidation()},
+			params:     `{"name": "foo"}`,
+			respStatus: http.StatusOK,
+		},
+		{
+			opts:       []ServeMuxOption{WithRequestValidation()},
+			params:     `{"parent": {}}`,
+			respStatus: http.StatusBadRequest,
+			wantCode:   InvalidParamsErrorCode,
+			want: []*errdetails.BadRequest_FieldViolation{
+				{Field: "name", Description: "value is required"},
+				{Field: "parent.id", Description: "value must be greater than 0"},
+			},
+		},
+		{
+			opts: []ServeMuxOption{WithValidator(ValidatorFunc(func(ctx context.Context, req proto.Message) error {
+				return fmt.Errorf("rejected")
+			}))},
+			params:     `{"name": "foo"}`,
+			respStatus: http.StatusBadRequest,
+			wantCode:   InvalidParamsErrorCode,
+		},
+		{
+			opts: []ServeMuxOption{WithValidator(ValidatorFunc(func(ctx context.Context, req proto.Message) error {
+				return status.Error(codes.PermissionDenied, "denied")
+			}))},
+			params:     `{"name": "foo"}`,
+			respStatus: http.StatusForbidden,
+			wantCode:   errorCode(codes.PermissionDenied),
+		},
+		{
+			opts: []ServeMuxOption{WithValidator(ValidatorFunc(func(ctx context.Context, req proto.Message) error {
+				s, _ := status.New(codes.InvalidArgument, "invalid").WithDetails(&errdetails.BadRequest{
+					FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "name", Description: "too short"}},
+				})
+				return s.Err()
+			}))},
+			params:     `{"name": "foo"}`,
+			respStatus: http.StatusBadRequest,
+			wantCode:   InvalidParamsErrorCode,
+			want: []*errdetails.BadRequest_FieldViolation{
+				{Field: "name", Description: "too short"},
+			},
+		},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			mux := NewServeMux(spec.opts...)
+			mux.Register("Service.Hello", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+				params := validatedStruct{&structpb.Struct{}}
+				if err := marshaller.Unmarshal(rawBody, params.Struct); err != nil {
+					return nil, req.Context(), status.Error(codes.InvalidArgument, err.Error())
+				}
+				if err := mux.ValidateRequest(req.Context(), params); err != nil {
+					return nil, req.Context(), err
+				}
+				return params.Struct, req.Context(), nil
+			})
+
+			body := `{"jsonrpc": "2.0", "method": "Service.Hello", "id": "1", "params": ` + spec.params + `}`
+			r := httptest.NewRequest("POST", "https://host.example/", bytes.NewReader([]byte(body)))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+
+			assert.Equal(t, spec.respStatus, w.Code)
+			if spec.respStatus == http.StatusOK {
+				return
+			}
+			var got struct {
+				Error struct {
+					Code int               `json:"code"`
+					Data []json.RawMessage `json:"data"`
+				} `json:"error"`
+			}
+			assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+			assert.Equal(t, spec.wantCode, got.Error.Code)
+			if spec.want == nil {
+				return
+			}
+			if assert.Len(t, got.Error.Data, 1) {
+				var (
+					detail errdetails.BadRequest
+					any    anypb.Any
+				)
+				assert.NoError(t, protojson.Unmarshal(got.Error.Data[0], &any))
+				assert.NoError(t, any.UnmarshalTo(&detail))
+				assert.True(t, proto.Equal(&errdetails.BadRequest{FieldViolations: spec.want}, &detail), "got %v", &detail)
+			}
+		})
+	}
+}
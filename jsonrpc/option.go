@@ -1,13 +1,40 @@
 package jsonrpc
 
-import "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+import (
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
 
 // ServeMuxOption is an option that can be given to a ServeMux on construction.
 type ServeMuxOption func(*ServeMux)
 
+// WithMarshalerOption returns a ServeMuxOption which replaces the default
+// marshaler, used for every request without a marshaler registered for its MIME
+// types. It is a shorthand for WithMIMEMarshaler(runtime.MIMEWildcard, marshaler).
 func WithMarshalerOption(marshaler runtime.Marshaler) ServeMuxOption {
+	return WithMIMEMarshaler(runtime.MIMEWildcard, marshaler)
+}
+
+// WithMIMEMarshaler returns a ServeMuxOption which associates inbound and outbound
+// Marshalers to a MIME type in mux. Use runtime.MIMEWildcard to replace the default
+// marshaler.
+func WithMIMEMarshaler(mime string, marshaler runtime.Marshaler) ServeMuxOption {
+	return func(s *ServeMux) {
+		if err := s.marshalers.add(mime, marshaler); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// WithMethodInResponse returns a ServeMuxOption which makes ServeMux copy the
+// request method into every response object.
+//
+// The "method" member is not part of a JSON-RPC 2.0 response and strict clients
+// treat such a reply as a request, so this should only be used for debugging.
+func WithMethodInResponse() ServeMuxOption {
 	return func(s *ServeMux) {
-		s.marshaller = marshaler
+		s.methodInResponse = true
 	}
 }
 
@@ -18,3 +45,66 @@ func WithGatewayRuntimeOptions(opts ...runtime.ServeMuxOption) ServeMuxOption {
 		}
 	}
 }
+
+// WithMaxTimeout returns a ServeMuxOption which caps the timeout of every call.
+// Timeouts requested by clients and method defaults longer than d are cut to d,
+// and calls without any timeout get d.
+func WithMaxTimeout(d time.Duration) ServeMuxOption {
+	return func(s *ServeMux) {
+		s.maxTimeout = d
+	}
+}
+
+// WithDiscover returns a ServeMuxOption which makes ServeMux answer the
+// "rpc.discover" and "rpc.listMethods" methods. The OpenRPC document answered
+// to "rpc.discover" is described by info, and methods registered with
+// WithGRPCMethod are described from their protobuf descriptors.
+//
+// The document follows the encoding of the marshaler and the
+// google.api.field_behavior annotations of fields, but it is coarser than the
+// one protoc-gen-jsonrpc-openrpc generates: descriptors linked in the binary
+// carry no comments, so nothing is described, enums are inlined rather than
+// components, wrappers are always nullable, and oneofs, proto3 optional
+// fields, deprecation, protoc-gen-validate rules, visibility restrictions and
+// the plugin parameters have no effect.
+func WithDiscover(info Info) ServeMuxOption {
+	return func(s *ServeMux) {
+		s.discover = true
+		s.info = info
+	}
+}
+
+// WithRequestValidation returns a ServeMuxOption which validates requests
+// with the ValidateAll or else Validate method generated by protoc-gen-validate
+// before forwarding them. Requests without such methods are forwarded as is.
+func WithRequestValidation() ServeMuxOption {
+	return WithValidator(ValidatorFunc(protocValidator))
+}
+
+// WithValidator returns a ServeMuxOption which validates requests with v
+// before forwarding them.
+func WithValidator(v Validator) ServeMuxOption {
+	return func(s *ServeMux) {
+		s.validator = v
+	}
+}
+
+// HandlerOption is an option that can be given to ServeMux.Register.
+type HandlerOption func(*handler)
+
+// WithDefaultTimeout returns a HandlerOption which sets the timeout of calls
+// that do not ask for one.
+func WithDefaultTimeout(d time.Duration) HandlerOption {
+	return func(h *handler) {
+		h.defaultTimeout = d
+	}
+}
+
+// WithGRPCMethod returns a HandlerOption which tells the gRPC method a handler
+// calls, as in "/pkg.Service/Method", so that it can be described by
+// "rpc.discover".
+func WithGRPCMethod(fullMethod string) HandlerOption {
+	return func(h *handler) {
+		h.grpcMethod = fullMethod
+	}
+}
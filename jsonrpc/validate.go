@@ -0,0 +1,112 @@
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Validator validates requests after they are decoded from the params of a
+// call and before they are forwarded to the backend.
+type Validator interface {
+	Validate(ctx context.Context, req proto.Message) error
+}
+
+// ValidatorFunc is a function implementing Validator.
+type ValidatorFunc func(ctx context.Context, req proto.Message) error
+
+// Validate calls f(ctx, req).
+func (f ValidatorFunc) Validate(ctx context.Context, req proto.Message) error {
+	return f(ctx, req)
+}
+
+// ValidateRequest validates req with the validator of the mux, if any. It is
+// called by generated handlers on the requests they decode.
+//
+// A failure is returned as an invalid params error whose data is a
+// google.rpc.BadRequest listing the field violations found by the validator.
+// A gRPC status error returned by the validator keeps its status, and is
+// reported as an invalid params error too if its code is InvalidArgument.
+func (s *ServeMux) ValidateRequest(ctx context.Context, req proto.Message) error {
+	if s.validator == nil {
+		return nil
+	}
+	err := s.validator.Validate(ctx, req)
+	if err == nil {
+		return nil
+	}
+	if st, ok := status.FromError(err); ok {
+		if st.Code() == codes.InvalidArgument {
+			return &statusError{s: st, code: InvalidParamsErrorCode}
+		}
+		return err
+	}
+	st := status.New(codes.InvalidArgument, err.Error())
+	if violations := fieldViolations("", err); len(violations) > 0 {
+		if detailed, derr := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); derr == nil {
+			st = detailed
+		}
+	}
+	return &statusError{s: st, code: InvalidParamsErrorCode}
+}
+
+// protocValidator validates requests generated by protoc-gen-validate, which
+// implement ValidateAll, Validate or both.
+func protocValidator(ctx context.Context, req proto.Message) error {
+	switch v := req.(type) {
+	case interface{ ValidateAll() error }:
+		return v.ValidateAll()
+	case interface{ Validate() error }:
+		return v.Validate()
+	}
+	return nil
+}
+
+// fieldError is implemented by the errors of the fields of messages generated
+// by protoc-gen-validate.
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError is implemented by the errors returned by ValidateAll methods
+// generated by protoc-gen-validate.
+type multiError interface {
+	AllErrors() []error
+}
+
+// fieldViolations returns the field violations described by err, whose fields
+// are relative to prefix. Errors of embedded messages are reported against the
+// path of their fields, as in "parent.child".
+func fieldViolations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	var multi multiError
+	if errors.As(err, &multi) {
+		var violations []*errdetails.BadRequest_FieldViolation
+		for _, err := range multi.AllErrors() {
+			violations = append(violations, fieldViolations(prefix, err)...)
+		}
+		return violations
+	}
+	var field fieldError
+	if !errors.As(err, &field) {
+		return nil
+	}
+	path := field.Field()
+	if prefix != "" {
+		path = prefix + "." + path
+	}
+	if cause := field.Cause(); cause != nil {
+		if nested := fieldViolations(path, cause); len(nested) > 0 {
+			return nested
+		}
+	}
+	return []*errdetails.BadRequest_FieldViolation{{
+		Field:       path,
+		Description: field.Reason(),
+	}}
+}
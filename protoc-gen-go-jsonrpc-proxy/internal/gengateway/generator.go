@@ -109,12 +109,19 @@ func (g *generator) generate(file *descriptor.File) (string, error) {
 
 	for _, svc := range file.Services {
 		for _, m := range svc.Methods {
-			pkg := m.RequestType.File.GoPkg
-			if pkg == file.GoPkg || pkgSeen[pkg.Path] {
-				continue
+			if methodDefaultTimeout(m) > 0 && !pkgSeen["time"] {
+				pkgSeen["time"] = true
+				imports = append(imports, descriptor.GoPackage{Path: "time", Name: "time"})
+			}
+		}
+		for _, m := range svc.Methods {
+			for _, pkg := range []descriptor.GoPackage{m.RequestType.File.GoPkg, m.ResponseType.File.GoPkg} {
+				if pkg == file.GoPkg || pkgSeen[pkg.Path] {
+					continue
+				}
+				pkgSeen[pkg.Path] = true
+				imports = append(imports, pkg)
 			}
-			pkgSeen[pkg.Path] = true
-			imports = append(imports, pkg)
 		}
 	}
 	params := param{
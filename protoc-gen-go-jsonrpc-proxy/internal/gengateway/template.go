@@ -2,13 +2,17 @@ package gengateway
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/golang/glog"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/yxlimo/go-jsonrpc-gateway/internal/casing"
 	"github.com/yxlimo/go-jsonrpc-gateway/internal/descriptor"
+	"github.com/yxlimo/go-jsonrpc-gateway/protoc-gen-go-jsonrpc-proxy/options"
 )
 
 type param struct {
@@ -26,6 +30,31 @@ type trailerParams struct {
 	RegisterFuncSuffix string
 }
 
+// methodDefaultTimeout returns the default_timeout set in the jsonrpc_method
+// option of m, or zero if there is none.
+func methodDefaultTimeout(m *descriptor.Method) time.Duration {
+	if m.Options == nil || !proto.HasExtension(m.Options, options.E_JsonrpcMethod) {
+		return 0
+	}
+	opts, ok := proto.GetExtension(m.Options, options.E_JsonrpcMethod).(*options.JSONRPCMethod)
+	if !ok || opts.GetDefaultTimeout() == nil {
+		return 0
+	}
+	return opts.GetDefaultTimeout().AsDuration()
+}
+
+// durationExpr returns a go expression of d.
+func durationExpr(d time.Duration) string {
+	switch {
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	case d%time.Millisecond == 0:
+		return fmt.Sprintf("%d * time.Millisecond", d/time.Millisecond)
+	default:
+		return fmt.Sprintf("time.Duration(%d)", d)
+	}
+}
+
 func applyTemplate(p param, reg *descriptor.Registry) (string, error) {
 	w := bytes.NewBuffer(nil)
 	if err := headerTemplate.Execute(w, p); err != nil {
@@ -74,6 +103,10 @@ func applyTemplate(p param, reg *descriptor.Registry) (string, error) {
 		glog.Errorf("executing trailer template: %v", err)
 		return "", err
 	}
+	if err := clientTemplate.Execute(w, tp); err != nil {
+		glog.Errorf("executing client template: %v", err)
+		return "", err
+	}
 	return w.String(), nil
 }
 
@@ -109,7 +142,7 @@ var _ = jsonrpc.NewHTTPServerConn
 `))
 
 	_ = template.Must(handlerTemplate.New("request-func-signature").Parse(strings.Replace(`
-func request_{{.Method.Service.GetName}}_{{.Method.GetName}}_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client {{.Method.Service.InstanceName}}Client, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error)
+func request_{{.Method.Service.GetName}}_{{.Method.GetName}}_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client {{.Method.Service.InstanceName}}Client, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error)
 `, "\n", "", -1)))
 
 	_ = template.Must(handlerTemplate.New("client-rpc-request-func").Parse(`
@@ -119,11 +152,23 @@ func request_{{.Method.Service.GetName}}_{{.Method.GetName}}_jsonrpc(ctx context
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF  {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.{{.Method.GetName}}(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }`))
 
-	trailerTemplate = template.Must(template.New("trailer").Parse(`
+	funcMap = template.FuncMap{
+		"defaultTimeout": func(m *descriptor.Method) string {
+			if d := methodDefaultTimeout(m); d > 0 {
+				return durationExpr(d)
+			}
+			return ""
+		},
+	}
+
+	trailerTemplate = template.Must(template.New("trailer").Funcs(funcMap).Parse(`
 {{range $svc := .Services}}
 // Register{{$svc.GetName}}{{$.RegisterFuncSuffix}}FromEndpoint is same as Register{{$svc.GetName}}{{$.RegisterFuncSuffix}} but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
@@ -164,7 +209,7 @@ func Register{{$svc.GetName}}{{$.RegisterFuncSuffix}}(ctx context.Context, mux *
 func Register{{$svc.GetName}}{{$.RegisterFuncSuffix}}Client(ctx context.Context, mux *jsonrpc.ServeMux, client {{$svc.InstanceName}}Client) error {
 	{{range $m := $svc.Methods}}
 	{{if and (not $m.GetServerStreaming) (not $m.GetClientStreaming)}}
-	mux.Register("{{$m.GetName}}", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("{{$m.GetName}}", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -172,22 +217,67 @@ func Register{{$svc.GetName}}{{$.RegisterFuncSuffix}}Client(ctx context.Context,
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_{{$svc.GetName}}_{{$m.GetName}}_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_{{$svc.GetName}}_{{$m.GetName}}_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/{{$svc.File.GetPackage}}.{{$svc.GetName}}/{{$m.GetName}}"){{with defaultTimeout $m}}, jsonrpc.WithDefaultTimeout({{.}}){{end}})
 	{{end}}
 	{{end}}
 	return nil
 }
 
 {{end}}`))
+
+	clientTemplate = template.Must(template.New("client").Parse(`
+{{range $svc := .Services}}
+{{if $svc.HiddenMethods -}}
+// {{$svc.GetName}}JSONRPCClient calls the methods of service {{$svc.GetName}} registered on a jsonrpc.ServeMux.
+// Methods hidden by visibility restrictions are left out, so it only implements {{$svc.GetName}}Client
+// without them. Streaming methods fail with codes.Unimplemented.
+{{- else -}}
+// {{$svc.GetName}}JSONRPCClient is a {{$svc.GetName}}Client calling the methods of service {{$svc.GetName}}
+// registered on a jsonrpc.ServeMux, so that callers can switch between gRPC and JSON-RPC transparently.
+// Streaming methods fail with codes.Unimplemented.
+{{- end}}
+type {{$svc.GetName}}JSONRPCClient struct {
+	cc *jsonrpc.Client
+}
+{{if not $svc.HiddenMethods}}
+var _ {{$svc.InstanceName}}Client = (*{{$svc.GetName}}JSONRPCClient)(nil)
+{{end}}
+
+// New{{$svc.GetName}}JSONRPCClient returns a {{$svc.GetName}}JSONRPCClient sending calls through "cc".
+func New{{$svc.GetName}}JSONRPCClient(cc *jsonrpc.Client) *{{$svc.GetName}}JSONRPCClient {
+	return &{{$svc.GetName}}JSONRPCClient{cc: cc}
+}
+{{range $m := $svc.Methods}}
+{{if and (not $m.GetServerStreaming) (not $m.GetClientStreaming)}}
+{{template "client-method" $m}}
+{{else}}
+{{template "client-unimplemented-method" $m}}
+{{end}}
+{{end}}
+{{end}}`))
+
+	_ = template.Must(clientTemplate.New("client-method-signature").Parse(strings.Replace(`
+func (c *{{.Service.GetName}}JSONRPCClient) {{.GetName}}(ctx context.Context,
+{{if not .GetClientStreaming}} in *{{.RequestType.GoType .Service.File.GoPkg.Path}},{{end}}
+ opts ...grpc.CallOption)
+{{if or .GetServerStreaming .GetClientStreaming}} ({{.Service.InstanceName}}_{{.GetName}}Client, error)
+{{else}} (*{{.ResponseType.GoType .Service.File.GoPkg.Path}}, error){{end}}
+`, "\n", "", -1)))
+
+	_ = template.Must(clientTemplate.New("client-method").Parse(`
+{{template "client-method-signature" .}} {
+	out := new({{.ResponseType.GoType .Service.File.GoPkg.Path}})
+	if err := c.cc.Call(ctx, "{{.GetName}}", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}`))
+
+	_ = template.Must(clientTemplate.New("client-unimplemented-method").Parse(`
+{{template "client-method-signature" .}} {
+	return nil, status.Errorf(codes.Unimplemented, "method {{.GetName}} is not served over JSON-RPC")
+}`))
 )
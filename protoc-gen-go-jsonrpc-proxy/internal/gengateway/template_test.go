@@ -3,11 +3,14 @@ package gengateway
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/yxlimo/go-jsonrpc-gateway/internal/descriptor"
+	"github.com/yxlimo/go-jsonrpc-gateway/protoc-gen-go-jsonrpc-proxy/options"
 )
 
 func crossLinkFixture(f *descriptor.File) *descriptor.File {
@@ -19,6 +22,9 @@ func crossLinkFixture(f *descriptor.File) *descriptor.File {
 		for _, m := range svc.Methods {
 			m.Service = svc
 		}
+		for _, m := range svc.HiddenMethods {
+			m.Service = svc
+		}
 	}
 	return f
 }
@@ -150,6 +156,9 @@ func TestIdentifierCapitalization(t *testing.T) {
 	if want := `msg, err := client.ExamplEPost(ctx, &protoReq, grpc.Header(&metadata.HeaderMD)`; !strings.Contains(got, want) {
 		t.Errorf("applyTemplate(%#v) = %s; want to contain %s", file, got, want)
 	}
+	if want := `if err := mux.ValidateRequest(ctx, &protoReq); err != nil {`; !strings.Contains(got, want) {
+		t.Errorf("applyTemplate(%#v) = %s; want to contain %s", file, got, want)
+	}
 	if want := `var protoReq ExamPleRequest`; !strings.Contains(got, want) {
 		t.Errorf("applyTemplate(%#v) = %s; want to contain %s", file, got, want)
 	}
@@ -157,3 +166,177 @@ func TestIdentifierCapitalization(t *testing.T) {
 		t.Errorf("applyTemplate(%#v) = %s; want to contain %s", file, got, want)
 	}
 }
+
+func TestApplyTemplateDefaultTimeout(t *testing.T) {
+	msgdesc := &descriptorpb.DescriptorProto{
+		Name: proto.String("ExampleMessage"),
+	}
+	methOpts := &descriptorpb.MethodOptions{}
+	proto.SetExtension(methOpts, options.E_JsonrpcMethod, &options.JSONRPCMethod{
+		DefaultTimeout: durationpb.New(1500 * time.Millisecond),
+	})
+	meth1 := &descriptorpb.MethodDescriptorProto{
+		Name:       proto.String("Slow"),
+		InputType:  proto.String("ExampleMessage"),
+		OutputType: proto.String("ExampleMessage"),
+		Options:    methOpts,
+	}
+	meth2 := &descriptorpb.MethodDescriptorProto{
+		Name:       proto.String("Fast"),
+		InputType:  proto.String("ExampleMessage"),
+		OutputType: proto.String("ExampleMessage"),
+	}
+	svc := &descriptorpb.ServiceDescriptorProto{
+		Name:   proto.String("ExampleService"),
+		Method: []*descriptorpb.MethodDescriptorProto{meth1, meth2},
+	}
+	msg := &descriptor.Message{
+		DescriptorProto: msgdesc,
+	}
+	file := descriptor.File{
+		FileDescriptorProto: &descriptorpb.FileDescriptorProto{
+			Name:        proto.String("example.proto"),
+			Package:     proto.String("example"),
+			MessageType: []*descriptorpb.DescriptorProto{msgdesc},
+			Service:     []*descriptorpb.ServiceDescriptorProto{svc},
+		},
+		GoPkg: descriptor.GoPackage{
+			Path: "example.com/path/to/example/example.pb",
+			Name: "example_pb",
+		},
+		Messages: []*descriptor.Message{msg},
+		Services: []*descriptor.Service{
+			{
+				ServiceDescriptorProto: svc,
+				Methods: []*descriptor.Method{
+					{
+						MethodDescriptorProto: meth1,
+						RequestType:           msg,
+						ResponseType:          msg,
+					},
+					{
+						MethodDescriptorProto: meth2,
+						RequestType:           msg,
+						ResponseType:          msg,
+					},
+				},
+			},
+		},
+	}
+	got, err := applyTemplate(param{File: crossLinkFixture(&file)}, descriptor.NewRegistry())
+	if err != nil {
+		t.Errorf("applyTemplate(%#v) failed with %v; want success", file, err)
+		return
+	}
+	if want := `}, jsonrpc.WithGRPCMethod("/example.ExampleService/Slow"), jsonrpc.WithDefaultTimeout(1500 * time.Millisecond))`; !strings.Contains(got, want) {
+		t.Errorf("applyTemplate(%#v) = %s; want to contain %s", file, got, want)
+	}
+	if want := `}, jsonrpc.WithGRPCMethod("/example.ExampleService/Fast"))`; !strings.Contains(got, want) {
+		t.Errorf("applyTemplate(%#v) = %s; want to contain %s", file, got, want)
+	}
+	if want := 1; strings.Count(got, "jsonrpc.WithDefaultTimeout") != want {
+		t.Errorf("applyTemplate(%#v) = %s; want %d default timeout", file, got, want)
+	}
+}
+
+func TestApplyTemplateClient(t *testing.T) {
+	msgdesc := &descriptorpb.DescriptorProto{
+		Name: proto.String("ExampleMessage"),
+	}
+	unary := &descriptorpb.MethodDescriptorProto{
+		Name:       proto.String("Get"),
+		InputType:  proto.String("ExampleMessage"),
+		OutputType: proto.String("ExampleMessage"),
+	}
+	bidi := &descriptorpb.MethodDescriptorProto{
+		Name:            proto.String("Chat"),
+		InputType:       proto.String("ExampleMessage"),
+		OutputType:      proto.String("ExampleMessage"),
+		ClientStreaming: proto.Bool(true),
+		ServerStreaming: proto.Bool(true),
+	}
+	hidden := &descriptorpb.MethodDescriptorProto{
+		Name:       proto.String("internal_get"),
+		InputType:  proto.String("ExampleMessage"),
+		OutputType: proto.String("ExampleMessage"),
+	}
+	svc := &descriptorpb.ServiceDescriptorProto{
+		Name:   proto.String("ExampleService"),
+		Method: []*descriptorpb.MethodDescriptorProto{unary, bidi, hidden},
+	}
+	msg := &descriptor.Message{
+		DescriptorProto: msgdesc,
+	}
+	file := descriptor.File{
+		FileDescriptorProto: &descriptorpb.FileDescriptorProto{
+			Name:        proto.String("example.proto"),
+			Package:     proto.String("example"),
+			MessageType: []*descriptorpb.DescriptorProto{msgdesc},
+			Service:     []*descriptorpb.ServiceDescriptorProto{svc},
+		},
+		GoPkg: descriptor.GoPackage{
+			Path: "example.com/path/to/example/example.pb",
+			Name: "example_pb",
+		},
+		Messages: []*descriptor.Message{msg},
+		Services: []*descriptor.Service{
+			{
+				ServiceDescriptorProto: svc,
+				Methods: []*descriptor.Method{
+					{
+						MethodDescriptorProto: unary,
+						RequestType:           msg,
+						ResponseType:          msg,
+					},
+					{
+						MethodDescriptorProto: bidi,
+						RequestType:           msg,
+						ResponseType:          msg,
+					},
+				},
+				HiddenMethods: []*descriptor.Method{
+					{
+						MethodDescriptorProto: hidden,
+						RequestType:           msg,
+						ResponseType:          msg,
+					},
+				},
+			},
+		},
+	}
+	got, err := applyTemplate(param{File: crossLinkFixture(&file)}, descriptor.NewRegistry())
+	if err != nil {
+		t.Errorf("applyTemplate(%#v) failed with %v; want success", file, err)
+		return
+	}
+	for _, want := range []string{
+		`func (c *ExampleServiceJSONRPCClient) Get(ctx context.Context, in *ExampleMessage, opts ...grpc.CallOption) (*ExampleMessage, error) {`,
+		`if err := c.cc.Call(ctx, "Get", in, out, opts...); err != nil {`,
+		`func (c *ExampleServiceJSONRPCClient) Chat(ctx context.Context, opts ...grpc.CallOption) (ExampleService_ChatClient, error) {`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("applyTemplate(%#v) = %s; want to contain %s", file, got, want)
+		}
+	}
+	if strings.Contains(got, `mux.Register("InternalGet"`) {
+		t.Errorf("applyTemplate(%#v) = %s; want hidden method InternalGet not to be registered", file, got)
+	}
+	if strings.Contains(got, `InternalGet(`) {
+		t.Errorf("applyTemplate(%#v) = %s; want hidden method InternalGet not to be in the client", file, got)
+	}
+	assertion := `var _ ExampleServiceClient = (*ExampleServiceJSONRPCClient)(nil)`
+	if strings.Contains(got, assertion) {
+		t.Errorf("applyTemplate(%#v) = %s; want no %s since a method is hidden", file, got, assertion)
+	}
+
+	file.Services[0].HiddenMethods = nil
+	svc.Method = []*descriptorpb.MethodDescriptorProto{unary, bidi}
+	got, err = applyTemplate(param{File: crossLinkFixture(&file)}, descriptor.NewRegistry())
+	if err != nil {
+		t.Errorf("applyTemplate(%#v) failed with %v; want success", file, err)
+		return
+	}
+	if !strings.Contains(got, assertion) {
+		t.Errorf("applyTemplate(%#v) = %s; want to contain %s", file, got, assertion)
+	}
+}
@@ -24,6 +24,8 @@ import (
 var (
 	standalone  = flag.Bool("standalone", false, "generates a standalone gateway package, which imports the target service package")
 	versionFlag = flag.Bool("version", false, "print the current version")
+
+	visibilityRestrictionSelectors = flag.String("visibility_restriction_selectors", "", "semicolon separated list of `google.api.VisibilityRule` visibility labels to include in the generated gateway, e.g. INTERNAL;PREVIEW. If a method or service has no visibility rule or one of its labels is listed, it is registered.")
 )
 
 // Variables set by goreleaser at build time
@@ -112,5 +114,6 @@ func parseFlags(reg *descriptor.Registry, parameter string) {
 
 func applyFlags(reg *descriptor.Registry) error {
 	reg.SetStandalone(*standalone)
+	reg.SetVisibilityRestrictionSelectors(strings.Split(*visibilityRestrictionSelectors, ";"))
 	return nil
 }
@@ -0,0 +1,112 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: protoc-gen-go-jsonrpc-proxy/options/annotations.proto
+
+package options
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var file_protoc_gen_go_jsonrpc_proxy_options_annotations_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*JSONRPCMethod)(nil),
+		Field:         1151,
+		Name:          "jsonrpc.gateway.protoc_gen_go_jsonrpc_proxy.options.jsonrpc_method",
+		Tag:           "bytes,1151,opt,name=jsonrpc_method",
+		Filename:      "protoc-gen-go-jsonrpc-proxy/options/annotations.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// Customizes how the method is served by the JSON-RPC gateway.
+	//
+	// optional jsonrpc.gateway.protoc_gen_go_jsonrpc_proxy.options.JSONRPCMethod jsonrpc_method = 1151;
+	E_JsonrpcMethod = &file_protoc_gen_go_jsonrpc_proxy_options_annotations_proto_extTypes[0]
+)
+
+var File_protoc_gen_go_jsonrpc_proxy_options_annotations_proto protoreflect.FileDescriptor
+
+var file_protoc_gen_go_jsonrpc_proxy_options_annotations_proto_rawDesc = []byte{
+	0x0a, 0x35, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d,
+	0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x33, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f,
+	0x67, 0x65, 0x6e, 0x5f, 0x67, 0x6f, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x5f, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x20, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x31,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x6a, 0x73,
+	0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x3a, 0x8a, 0x01, 0x0a, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x5f, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xff, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x67, 0x6f, 0x5f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x72, 0x70, 0x63, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x52, 0x50, 0x43, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52,
+	0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x4a,
+	0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x78, 0x6c,
+	0x69, 0x6d, 0x6f, 0x2f, 0x67, 0x6f, 0x2d, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2d, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
+	0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2d, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var file_protoc_gen_go_jsonrpc_proxy_options_annotations_proto_goTypes = []interface{}{
+	(*descriptorpb.MethodOptions)(nil), // 0: google.protobuf.MethodOptions
+	(*JSONRPCMethod)(nil),              // 1: jsonrpc.gateway.protoc_gen_go_jsonrpc_proxy.options.JSONRPCMethod
+}
+var file_protoc_gen_go_jsonrpc_proxy_options_annotations_proto_depIdxs = []int32{
+	0, // 0: jsonrpc.gateway.protoc_gen_go_jsonrpc_proxy.options.jsonrpc_method:extendee -> google.protobuf.MethodOptions
+	1, // 1: jsonrpc.gateway.protoc_gen_go_jsonrpc_proxy.options.jsonrpc_method:type_name -> jsonrpc.gateway.protoc_gen_go_jsonrpc_proxy.options.JSONRPCMethod
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	1, // [1:2] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_protoc_gen_go_jsonrpc_proxy_options_annotations_proto_init() }
+func file_protoc_gen_go_jsonrpc_proxy_options_annotations_proto_init() {
+	if File_protoc_gen_go_jsonrpc_proxy_options_annotations_proto != nil {
+		return
+	}
+	file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protoc_gen_go_jsonrpc_proxy_options_annotations_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_protoc_gen_go_jsonrpc_proxy_options_annotations_proto_goTypes,
+		DependencyIndexes: file_protoc_gen_go_jsonrpc_proxy_options_annotations_proto_depIdxs,
+		ExtensionInfos:    file_protoc_gen_go_jsonrpc_proxy_options_annotations_proto_extTypes,
+	}.Build()
+	File_protoc_gen_go_jsonrpc_proxy_options_annotations_proto = out.File
+	file_protoc_gen_go_jsonrpc_proxy_options_annotations_proto_rawDesc = nil
+	file_protoc_gen_go_jsonrpc_proxy_options_annotations_proto_goTypes = nil
+	file_protoc_gen_go_jsonrpc_proxy_options_annotations_proto_depIdxs = nil
+}
@@ -0,0 +1,275 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: protoc-gen-go-jsonrpc-proxy/options/jsonrpc.proto
+
+package options
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// JSONRPCMethod customizes how a method is served by the JSON-RPC gateway.
+//
+// Example:
+//
+//	rpc Echo(EchoRequest) returns (EchoResponse) {
+//	  option (jsonrpc.gateway.protoc_gen_go_jsonrpc_proxy.options.jsonrpc_method) = {
+//	    default_timeout: { seconds: 5 }
+//	    errors: { code: 5, reason: "ECHO_NOT_FOUND", description: "Nothing to echo." }
+//	  };
+//	}
+type JSONRPCMethod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Timeout applied to calls of the method when the client does not ask for
+	// one, either with the Grpc-Timeout header or the "timeout" member of the
+	// request object. It is still capped by the maximum timeout of the ServeMux.
+	DefaultTimeout *durationpb.Duration `protobuf:"bytes,1,opt,name=default_timeout,json=defaultTimeout,proto3" json:"default_timeout,omitempty"`
+	// Errors the method is known to return, documented in the generated
+	// OpenAPI and OpenRPC documents.
+	Errors []*JSONRPCError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *JSONRPCMethod) Reset() {
+	*x = JSONRPCMethod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONRPCMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONRPCMethod) ProtoMessage() {}
+
+func (x *JSONRPCMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONRPCMethod.ProtoReflect.Descriptor instead.
+func (*JSONRPCMethod) Descriptor() ([]byte, []int) {
+	return file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JSONRPCMethod) GetDefaultTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.DefaultTimeout
+	}
+	return nil
+}
+
+func (x *JSONRPCMethod) GetErrors() []*JSONRPCError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// JSONRPCError documents an error returned by a method.
+type JSONRPCError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// JSON-RPC error code, which is the gRPC status code of the error except for
+	// DEADLINE_EXCEEDED reported as -32001.
+	Code int32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Reason of the google.rpc.ErrorInfo detail of the error, if any.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Description of what causes the error.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *JSONRPCError) Reset() {
+	*x = JSONRPCError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONRPCError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONRPCError) ProtoMessage() {}
+
+func (x *JSONRPCError) ProtoReflect() protoreflect.Message {
+	mi := &file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONRPCError.ProtoReflect.Descriptor instead.
+func (*JSONRPCError) Descriptor() ([]byte, []int) {
+	return file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JSONRPCError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *JSONRPCError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *JSONRPCError) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto protoreflect.FileDescriptor
+
+var file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_rawDesc = []byte{
+	0x0a, 0x31, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d,
+	0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x33, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f,
+	0x67, 0x6f, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xae, 0x01, 0x0a, 0x0d, 0x4a, 0x53, 0x4f,
+	0x4e, 0x52, 0x50, 0x43, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x42, 0x0a, 0x0f, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x59,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41,
+	0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x67, 0x6f, 0x5f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x52, 0x50, 0x43, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x5c, 0x0a, 0x0c, 0x4a, 0x53, 0x4f,
+	0x4e, 0x52, 0x50, 0x43, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x78, 0x6c, 0x69, 0x6d, 0x6f, 0x2f, 0x67, 0x6f, 0x2d,
+	0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x6a, 0x73,
+	0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_rawDescOnce sync.Once
+	file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_rawDescData = file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_rawDesc
+)
+
+func file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_rawDescGZIP() []byte {
+	file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_rawDescOnce.Do(func() {
+		file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_rawDescData)
+	})
+	return file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_rawDescData
+}
+
+var file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_goTypes = []interface{}{
+	(*JSONRPCMethod)(nil),       // 0: jsonrpc.gateway.protoc_gen_go_jsonrpc_proxy.options.JSONRPCMethod
+	(*JSONRPCError)(nil),        // 1: jsonrpc.gateway.protoc_gen_go_jsonrpc_proxy.options.JSONRPCError
+	(*durationpb.Duration)(nil), // 2: google.protobuf.Duration
+}
+var file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_depIdxs = []int32{
+	2, // 0: jsonrpc.gateway.protoc_gen_go_jsonrpc_proxy.options.JSONRPCMethod.default_timeout:type_name -> google.protobuf.Duration
+	1, // 1: jsonrpc.gateway.protoc_gen_go_jsonrpc_proxy.options.JSONRPCMethod.errors:type_name -> jsonrpc.gateway.protoc_gen_go_jsonrpc_proxy.options.JSONRPCError
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_init() }
+func file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_init() {
+	if File_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSONRPCMethod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JSONRPCError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_goTypes,
+		DependencyIndexes: file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_depIdxs,
+		MessageInfos:      file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_msgTypes,
+	}.Build()
+	File_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto = out.File
+	file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_rawDesc = nil
+	file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_goTypes = nil
+	file_protoc_gen_go_jsonrpc_proxy_options_jsonrpc_proto_depIdxs = nil
+}
@@ -0,0 +1,45 @@
+package openapi
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	pgs "github.com/lyft/protoc-gen-star"
+
+	"github.com/yxlimo/go-jsonrpc-gateway/internal/schemagen"
+)
+
+// comments returns the leading and trailing comments of e, executed as a Go
+// template with e as data if use_go_templates is set.
+func (s *Openapi) comments(e pgs.Entity) string {
+	comment := schemagen.Comments(e)
+	if !s.useGoTemplate || comment == "" {
+		return comment
+	}
+	tmpl, err := template.New(e.FullyQualifiedName()).Funcs(template.FuncMap{
+		// import inserts the content of a file, for documentation shared
+		// by several entities
+		"import": importFile,
+	}).Parse(comment)
+	s.base.CheckErr(err, "parse comments of ", e.FullyQualifiedName())
+	var buf bytes.Buffer
+	s.base.CheckErr(tmpl.Execute(&buf, e), "execute comments of ", e.FullyQualifiedName())
+	return buf.String()
+}
+
+// importFile returns the content of the file name, relative to the working
+// directory of protoc, the root the proto files are found in. Files out of it,
+// absolute paths included, are not read.
+func importFile(name string) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(name))
+	if filepath.IsAbs(clean) || filepath.VolumeName(clean) != "" ||
+		clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("import %q: file is not under the proto source root", name)
+	}
+	content, err := ioutil.ReadFile(clean)
+	return string(content), err
+}
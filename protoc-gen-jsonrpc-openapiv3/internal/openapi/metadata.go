@@ -0,0 +1,182 @@
+package openapi
+
+import (
+	"io/ioutil"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yxlimo/go-jsonrpc-gateway/protoc-gen-jsonrpc-openapiv3/options"
+)
+
+// parameterMetadata returns the document metadata set by plugin parameters:
+// the openapi_configuration file, in the JSON encoding of options.OpenAPI, then
+// the title, version and description parameters.
+//
+// Plugin parameters are separated by commas, so the title, version and
+// description parameters cannot contain any: a comma ends the value. Values
+// with commas are set in the openapi_configuration file instead.
+func (s *Openapi) parameterMetadata(params pgs.Parameters) *options.OpenAPI {
+	metadata := &options.OpenAPI{}
+	if name := params.Str("openapi_configuration"); name != "" {
+		content, err := ioutil.ReadFile(name)
+		s.base.CheckErr(err, "read openapi_configuration")
+		s.base.CheckErr(protojson.Unmarshal(content, metadata), "parse openapi_configuration ", name)
+	}
+	info := &options.Info{
+		Title:       params.Str("title"),
+		Version:     params.Str("version"),
+		Description: params.Str("description"),
+	}
+	proto.Merge(metadata, &options.OpenAPI{Info: info})
+	return metadata
+}
+
+// documentMetadata returns the metadata of the document generated for files:
+// their openapiv3_document options, in order, overridden by plugin parameters.
+func (s *Openapi) documentMetadata(files []pgs.File) *options.OpenAPI {
+	metadata := &options.OpenAPI{}
+	for _, file := range files {
+		var opts *options.OpenAPI
+		_, err := file.Extension(options.E_Openapiv3Document, &opts)
+		s.base.CheckErr(err, "read openapiv3_document option of ", file.Name())
+		if opts != nil {
+			mergeMetadata(metadata, opts)
+		}
+	}
+	mergeMetadata(metadata, s.metadata)
+	for name, scheme := range metadata.GetSecuritySchemes() {
+		if scheme.GetType() == options.SecurityScheme_OAUTH2 && !hasOAuthFlow(scheme.GetFlows()) {
+			s.base.Failf("security scheme %s: an OAUTH2 scheme must set flows", name)
+		}
+	}
+	return metadata
+}
+
+// mergeMetadata merges src into dst like proto.Merge, except that the servers
+// and security requirements of src replace those of dst instead of being
+// appended to them.
+func mergeMetadata(dst, src *options.OpenAPI) {
+	src = proto.Clone(src).(*options.OpenAPI)
+	if len(src.Servers) > 0 {
+		dst.Servers, src.Servers = src.Servers, nil
+	}
+	if len(src.Security) > 0 {
+		dst.Security, src.Security = src.Security, nil
+	}
+	proto.Merge(dst, src)
+}
+
+// hasOAuthFlow reports whether flows sets any flow.
+func hasOAuthFlow(flows *options.OAuthFlows) bool {
+	return flows.GetImplicit() != nil || flows.GetPassword() != nil ||
+		flows.GetClientCredentials() != nil || flows.GetAuthorizationCode() != nil
+}
+
+// applyMetadata sets the metadata of object, keeping its generated info when
+// metadata leaves it unset.
+func applyMetadata(object *openapiObject, metadata *options.OpenAPI) {
+	if info := metadata.GetInfo(); info != nil {
+		if info.GetTitle() != "" {
+			object.Info.Title = info.GetTitle()
+		}
+		if info.GetVersion() != "" {
+			object.Info.Version = info.GetVersion()
+		}
+		object.Info.Description = info.GetDescription()
+		object.Info.TermsOfService = info.GetTermsOfService()
+		if contact := info.GetContact(); contact != nil {
+			object.Info.Contact = &openapiContactObject{
+				Name:  contact.GetName(),
+				URL:   contact.GetUrl(),
+				Email: contact.GetEmail(),
+			}
+		}
+		if license := info.GetLicense(); license != nil {
+			object.Info.License = &openapiLicenseObject{
+				Name: license.GetName(),
+				URL:  license.GetUrl(),
+			}
+		}
+	}
+	for _, server := range metadata.GetServers() {
+		object.Servers = append(object.Servers, &openapiServerObject{
+			URL:         server.GetUrl(),
+			Description: server.GetDescription(),
+		})
+	}
+	for name, scheme := range metadata.GetSecuritySchemes() {
+		if object.Components.SecuritySchemes == nil {
+			object.Components.SecuritySchemes = make(map[string]*openapiSecuritySchemeObject)
+		}
+		object.Components.SecuritySchemes[name] = &openapiSecuritySchemeObject{
+			Type:             securitySchemeTypes[scheme.GetType()],
+			Description:      scheme.GetDescription(),
+			Name:             scheme.GetName(),
+			In:               strings.ToLower(inNames[scheme.GetIn()]),
+			Scheme:           scheme.GetScheme(),
+			BearerFormat:     scheme.GetBearerFormat(),
+			OpenIDConnectURL: scheme.GetOpenIdConnectUrl(),
+			Flows:            oauthFlows(scheme.GetFlows()),
+		}
+	}
+	for _, requirement := range metadata.GetSecurity() {
+		schemes := make(map[string][]string, len(requirement.GetSecurityRequirement()))
+		for name, value := range requirement.GetSecurityRequirement() {
+			// scopes are always a list, even if empty
+			schemes[name] = append([]string{}, value.GetScope()...)
+		}
+		object.Security = append(object.Security, schemes)
+	}
+	if docs := metadata.GetExternalDocs(); docs != nil {
+		object.ExternalDocs = &openapiExternalDocumentationObject{
+			Description: docs.GetDescription(),
+			URL:         docs.GetUrl(),
+		}
+	}
+}
+
+// oauthFlows returns the flows object of an OAUTH2 scheme, nil for others.
+func oauthFlows(flows *options.OAuthFlows) *openapiOAuthFlowsObject {
+	if !hasOAuthFlow(flows) {
+		return nil
+	}
+	return &openapiOAuthFlowsObject{
+		Implicit:          oauthFlow(flows.GetImplicit()),
+		Password:          oauthFlow(flows.GetPassword()),
+		ClientCredentials: oauthFlow(flows.GetClientCredentials()),
+		AuthorizationCode: oauthFlow(flows.GetAuthorizationCode()),
+	}
+}
+
+func oauthFlow(flow *options.OAuthFlow) *openapiOAuthFlowObject {
+	if flow == nil {
+		return nil
+	}
+	// scopes are always a map, even if empty
+	scopes := make(map[string]string, len(flow.GetScopes()))
+	for name, description := range flow.GetScopes() {
+		scopes[name] = description
+	}
+	return &openapiOAuthFlowObject{
+		AuthorizationURL: flow.GetAuthorizationUrl(),
+		TokenURL:         flow.GetTokenUrl(),
+		RefreshURL:       flow.GetRefreshUrl(),
+		Scopes:           scopes,
+	}
+}
+
+var securitySchemeTypes = map[options.SecurityScheme_Type]string{
+	options.SecurityScheme_API_KEY:         "apiKey",
+	options.SecurityScheme_HTTP:            "http",
+	options.SecurityScheme_OAUTH2:          "oauth2",
+	options.SecurityScheme_OPEN_ID_CONNECT: "openIdConnect",
+}
+
+var inNames = map[options.SecurityScheme_In]string{
+	options.SecurityScheme_QUERY:  "QUERY",
+	options.SecurityScheme_HEADER: "HEADER",
+	options.SecurityScheme_COOKIE: "COOKIE",
+}
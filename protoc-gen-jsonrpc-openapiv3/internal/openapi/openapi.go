@@ -1,11 +1,18 @@
 package openapi
 
 import (
-	"encoding/json"
+	"fmt"
+	"sort"
 	"strings"
 
 	pgs "github.com/lyft/protoc-gen-star"
 	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"google.golang.org/genproto/googleapis/api/visibility"
+
+	"github.com/yxlimo/go-jsonrpc-gateway/internal/jsonschema"
+	"github.com/yxlimo/go-jsonrpc-gateway/internal/schemagen"
+	proxyoptions "github.com/yxlimo/go-jsonrpc-gateway/protoc-gen-go-jsonrpc-proxy/options"
+	"github.com/yxlimo/go-jsonrpc-gateway/protoc-gen-jsonrpc-openapiv3/options"
 )
 
 var _ pgs.Module = &Openapi{}
@@ -14,23 +21,40 @@ type Openapi struct {
 	base *pgs.ModuleBase
 	ctx  pgsgo.Context
 
-	schemas              map[string]*openapiSchemaObject
-	paths                map[string]*openapiPathObject
-	importMessages       map[string]pgs.Message
-	importMessageGen     map[string]bool
-	msgTypes             map[string]string
-	registeredImportFile map[string]bool
+	// useGoTemplate executes comments as Go templates.
+	useGoTemplate bool
+	// includePackageInTags prefixes tags with the package of their service.
+	includePackageInTags bool
+	// streaming documents streaming methods with the subscription protocol
+	// of a future streaming transport: a call returns a subscription id and
+	// the messages of the stream are sent as <Method>_subscription
+	// notifications. jsonrpc.ServeMux does not implement that transport yet
+	// and answers streaming methods with Unimplemented, so they are left out
+	// by default.
+	streaming bool
+
+	// allowMerge generates a single document named mergeFileName for all the
+	// files to generate instead of a document per file.
+	allowMerge    bool
+	mergeFileName string
+	// metadata of documents set by plugin parameters
+	metadata *options.OpenAPI
+	// outputFormat is json or yaml
+	outputFormat string
+	// indent is the number of spaces to indent documents with, 0 for compact
+	// JSON or YAML indented by 2 spaces
+	indent int
+
+	// schemas generates the component schemas, and paths and tags are the
+	// other contents, of the document being generated
+	schemas *schemagen.Generator
+	paths   map[string]*openapiPathObject
+	tags    []*openapiTagObject
 }
 
 func New() *Openapi {
 	return &Openapi{
-		base:                 &pgs.ModuleBase{},
-		schemas:              make(map[string]*openapiSchemaObject),
-		paths:                make(map[string]*openapiPathObject),
-		msgTypes:             make(map[string]string),
-		importMessages:       make(map[string]pgs.Message),
-		importMessageGen:     make(map[string]bool),
-		registeredImportFile: make(map[string]bool),
+		base: &pgs.ModuleBase{},
 	}
 }
 
@@ -41,69 +65,134 @@ func (o *Openapi) Name() string {
 func (o *Openapi) InitContext(c pgs.BuildContext) {
 	o.base.InitContext(c)
 	o.ctx = pgsgo.InitContext(c.Parameters())
+	o.schemas = schemagen.New(o.base, jsonschema.OpenAPI)
+	o.schemas.Comments = o.comments
+	useGoTemplate, err := c.Parameters().BoolDefault("use_go_templates", false)
+	o.base.CheckErr(err, "invalid use_go_templates parameter")
+	o.useGoTemplate = useGoTemplate
+	allowMerge, err := c.Parameters().BoolDefault("allow_merge", false)
+	o.base.CheckErr(err, "invalid allow_merge parameter")
+	o.allowMerge = allowMerge
+	o.mergeFileName = c.Parameters().StrDefault("merge_file_name", "apidocs")
+	includePackageInTags, err := c.Parameters().BoolDefault("include_package_in_tags", false)
+	o.base.CheckErr(err, "invalid include_package_in_tags parameter")
+	o.includePackageInTags = includePackageInTags
+	streaming, err := c.Parameters().BoolDefault("streaming_transport", false)
+	o.base.CheckErr(err, "invalid streaming_transport parameter")
+	o.streaming = streaming
+	o.metadata = o.parameterMetadata(c.Parameters())
+	o.outputFormat = c.Parameters().StrDefault("output_format", formatJSON)
+	if o.outputFormat != formatJSON && o.outputFormat != formatYAML {
+		o.base.Failf("invalid output_format parameter: %q, want %q or %q", o.outputFormat, formatJSON, formatYAML)
+	}
+	indent, err := c.Parameters().IntDefault("indent", 0)
+	o.base.CheckErr(err, "invalid indent parameter")
+	if indent < 0 {
+		o.base.Failf("invalid indent parameter: %d, want a number of spaces", indent)
+	}
+	o.indent = indent
 }
 
 func (o *Openapi) Execute(targets map[string]pgs.File, packages map[string]pgs.Package) []pgs.Artifact {
-	for _, t := range targets {
-		o.generate(t)
+	// generate in a stable order so that merged documents do not depend on
+	// map iteration
+	names := make([]string, 0, len(targets))
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if o.allowMerge {
+		o.reset()
+		files := make([]pgs.File, 0, len(names))
+		for _, name := range names {
+			files = append(files, targets[name])
+			o.genServices(targets[name])
+		}
+		o.write(o.mergeFileName+".openapi."+o.outputFormat, o.mergeFileName, o.documentMetadata(files))
+		return o.base.Artifacts()
+	}
+	for _, name := range names {
+		file := targets[name]
+		o.reset()
+		if len(file.Services()) == 0 {
+			o.genMessages(file)
+		} else {
+			o.genServices(file)
+		}
+		o.write(o.ctx.OutputPath(file).SetExt(".openapi."+o.outputFormat).String(), file.Name().String(), o.documentMetadata([]pgs.File{file}))
 	}
 	return o.base.Artifacts()
 }
 
-func (s *Openapi) generate(file pgs.File) {
-	s.registerImports(file.Imports())
-	s.registerMessageTypes(file.AllMessages())
-	for _, message := range file.AllMessages() {
-		s.base.Debugf("gen message: %s", message.FullyQualifiedName())
-		s.schemas[s.messageRefName(message.FullyQualifiedName())] = s.genMessage(message)
-	}
+// reset starts a new document.
+func (s *Openapi) reset() {
+	s.schemas.Reset(map[string]*openapiSchemaObject{errorSchemaName: errorSchema})
+	s.paths = make(map[string]*openapiPathObject)
+	s.tags = nil
+}
+
+// genServices adds the methods of the services of file to the document, along
+// with the schemas they reference.
+func (s *Openapi) genServices(file pgs.File) {
 	for _, service := range file.Services() {
+		if !s.schemas.IsVisible(service, visibility.E_ApiVisibility) {
+			s.base.Debugf("skip restricted service: %s", service.FullyQualifiedName())
+			continue
+		}
 		s.base.Debugf("gen service: %s", service.FullyQualifiedName())
+		_, description := schemagen.SplitComments(s.comments(service), false)
+		s.tags = append(s.tags, &openapiTagObject{
+			Name:        s.tagName(service),
+			Description: description,
+		})
 		for _, method := range service.Methods() {
+			if !s.schemas.IsVisible(method, visibility.E_MethodVisibility) {
+				s.base.Debugf("skip restricted method: %s", method.FullyQualifiedName())
+				continue
+			}
+			if streamingKind(method) != "" && !s.streaming {
+				s.base.Debugf("skip streaming method: %s", method.FullyQualifiedName())
+				continue
+			}
 			s.paths["/"+method.Name().LowerSnakeCase().String()] = s.genMethod(method)
 		}
 	}
+}
+
+// genMessages adds the messages of file to the document, along with the
+// schemas they reference, so that a file without services documents its
+// messages.
+func (s *Openapi) genMessages(file pgs.File) {
+	for _, msg := range file.AllMessages() {
+		if msg.IsMapEntry() || !s.schemas.IsVisible(msg, visibility.E_MessageVisibility) {
+			continue
+		}
+		s.base.Debugf("gen message: %s", msg.FullyQualifiedName())
+		s.schemas.MessageSchema(msg)
+	}
+}
+
+// write writes the document to name, titled title unless metadata says
+// otherwise.
+func (s *Openapi) write(name, title string, metadata *options.OpenAPI) {
 	object := openapiObject{
 		Version: "3.0.0",
 		Info: openapiInfoObject{
-			Title:   file.Name().String(),
+			Title:   title,
 			Version: "0.0.1",
 		},
 	}
 	object.Paths = s.paths
-	object.Components.Schemas = s.schemas
-	content, _ := json.Marshal(&object)
-	name := s.ctx.OutputPath(file).SetExt(".openapi.json")
-	s.base.OverwriteCustomFile(name.String(), string(content), 0644)
-}
-
-func (s *Openapi) registerImports(imports []pgs.File) {
-	for _, file := range imports {
-		if s.registeredImportFile[file.InputPath().String()] {
-			return
-		}
-		s.registeredImportFile[file.InputPath().String()] = true
-		s.base.Debugf("registering imports: %s", file.InputPath().String())
-		for _, msg := range file.AllMessages() {
-			s.base.Debugf("registering import message: %s", msg.FullyQualifiedName())
-			s.importMessages[msg.FullyQualifiedName()] = msg
-			s.msgTypes[msg.FullyQualifiedName()] = msg.FullyQualifiedName()
-		}
-		s.registerImports(file.Imports())
-	}
-}
-
-func (s *Openapi) registerMessageTypes(messages []pgs.Message) {
-	for _, msg := range messages {
-		for _, entry := range msg.MapEntries() {
-			s.base.Debugf("registering map entries: %s", entry.FullyQualifiedName())
-			valueField := entry.Fields()[1]
-			if valueField.Type().ProtoType() == pgs.MessageT {
-				s.msgTypes[entry.FullyQualifiedName()] = valueField.Descriptor().GetTypeName()
-			}
-		}
-		s.msgTypes[msg.FullyQualifiedName()] = msg.FullyQualifiedName()
+	object.Components.Schemas = s.schemas.Schemas()
+	object.Tags = s.tags
+	sort.Slice(object.Tags, func(i, j int) bool { return object.Tags[i].Name < object.Tags[j].Name })
+	applyMetadata(&object, metadata)
+	content, err := s.encode(&object)
+	if err != nil {
+		s.base.AddError(fmt.Sprintf("marshal openapi document %s: %v", name, err))
+		return
 	}
+	s.base.OverwriteCustomFile(name, string(content), 0644)
 }
 
 func (s *Openapi) Parameters() pgs.Parameters {
@@ -111,108 +200,85 @@ func (s *Openapi) Parameters() pgs.Parameters {
 }
 
 func (s *Openapi) genMethod(m pgs.Method) *openapiPathObject {
-	return &openapiPathObject{
-		Post: &openapiOperationObject{
-			Summary:     m.SourceCodeInfo().LeadingComments(),
-			RequestBody: s.jsonrpcRequestSchema(m.Name().UpperCamelCase().String(), s.genSchemaFromMsg(m.Input())),
-			Responses: map[string]*openapiResponseObject{
-				"200": s.jsonrpcResponseSchema(m.Name().UpperCamelCase().String(), s.genSchemaFromMsg(m.Output())),
-			},
+	summary, description := schemagen.SplitComments(s.comments(m), false)
+	method := m.Name().UpperCamelCase().String()
+	operation := &openapiOperationObject{
+		Tags:        []string{s.tagName(m.Service())},
+		Summary:     summary,
+		Description: description,
+		OperationID: method,
+		RequestBody: s.jsonrpcRequestSchema(method, s.schemas.RequestSchema(m.Input())),
+		Responses: map[string]*openapiResponseObject{
+			"200":     s.jsonrpcResponseSchema(s.schemas.MessageSchema(m.Output())),
+			"default": s.jsonrpcErrorResponseSchema(s.genErrorSchema(m)),
 		},
+		Deprecated: m.Descriptor().GetOptions().GetDeprecated() ||
+			m.Service().Descriptor().GetOptions().GetDeprecated(),
 	}
-}
-
-func (s *Openapi) genSchemaFromMsg(msg pgs.Message) *openapiSchemaObject {
-	if wkt, ok := wktSchemas[msg.FullyQualifiedName()]; ok {
-		return wkt
-	}
-	s.genImportSchemaIfExist(msg.FullyQualifiedName())
-	return &openapiSchemaObject{
-		Ref: "#/components/schemas/" + s.messageRefName(msg.FullyQualifiedName()),
+	if kind := streamingKind(m); kind != "" {
+		// the call returns a subscription, and the messages of the stream are
+		// notified to it
+		operation.Description = strings.TrimSpace(operation.Description + "\n\n" + streamingNote)
+		operation.Streaming = kind
+		operation.Notification = s.jsonrpcNotificationSchema(method, s.schemas.MessageSchema(m.Output()))
+		operation.Responses["200"] = s.jsonrpcResponseSchema(subscriptionSchema)
 	}
+	return &openapiPathObject{Post: operation}
 }
 
-func (s *Openapi) messageRefName(fqn string) string {
-	packageNames := strings.Split(fqn, ".")
-	return packageNames[len(packageNames)-2] + "." + packageNames[len(packageNames)-1]
-}
-
-func (s *Openapi) genMessage(msg pgs.Message) *openapiSchemaObject {
-	schema := &openapiSchemaObject{Type: "object", Properties: make(map[string]*openapiSchemaObject, len(msg.Fields()))}
-	for _, field := range msg.Fields() {
-		schema.Properties[field.Name().LowerSnakeCase().String()] = s.genSchemaFromField(field)
+// streamingKind returns server, client or bidi for streaming methods, and an
+// empty string otherwise.
+func streamingKind(m pgs.Method) string {
+	switch {
+	case m.ClientStreaming() && m.ServerStreaming():
+		return "bidi"
+	case m.ClientStreaming():
+		return "client"
+	case m.ServerStreaming():
+		return "server"
 	}
-	return schema
+	return ""
 }
 
-func (s *Openapi) genSchemaFromField(field pgs.Field) *openapiSchemaObject {
-	if field.Type().IsEnum() {
-		enums := field.Type().Enum()
-		enumsStr := make([]string, 0, len(enums.Values()))
-		for _, v := range enums.Values() {
-			enumsStr = append(enumsStr, v.Name().String())
-		}
-		return &openapiSchemaObject{
-			Type: "string",
-			Enum: enumsStr,
-		}
+// tagName returns the tag grouping the operations of service, qualified by its
+// package if include_package_in_tags is set.
+func (s *Openapi) tagName(service pgs.Service) string {
+	if s.includePackageInTags && service.Package().ProtoName() != "" {
+		return service.Package().ProtoName().String() + "." + service.Name().String()
 	}
-	if field.Type().IsRepeated() {
-		return &openapiSchemaObject{
-			Type:  "array",
-			Items: s.genSchemaFromFieldProtoType(field, field.Type().Element().ProtoType()),
-		}
-	}
-	if field.Type().IsMap() {
-		if field.Type().Key().ProtoType() == pgs.StringT {
-			return &openapiSchemaObject{
-				Type:                 "object",
-				AdditionalProperties: s.genSchemaFromFieldProtoType(field, field.Type().Element().ProtoType()),
-			}
-		}
-	}
-	return s.genSchemaFromFieldProtoType(field, field.Type().ProtoType())
+	return service.Name().String()
 }
 
-func (s *Openapi) genSchemaFromFieldProtoType(field pgs.Field, typ pgs.ProtoType) *openapiSchemaObject {
-	switch typ {
-	case pgs.DoubleT:
-		return &openapiSchemaObject{Type: "string", Format: "double"}
-	case pgs.FloatT:
-		return &openapiSchemaObject{Type: "number", Format: "float"}
-	case pgs.Int64T, pgs.SFixed64, pgs.SInt64:
-		return &openapiSchemaObject{Type: "string", Format: "int64"}
-	case pgs.UInt64T, pgs.Fixed64T:
-		return &openapiSchemaObject{Type: "string", Format: "uint64"}
-	case pgs.Int32T, pgs.SFixed32, pgs.SInt32:
-		return &openapiSchemaObject{Type: "integer", Format: "int32"}
-	case pgs.UInt32T, pgs.Fixed32T:
-		return &openapiSchemaObject{Type: "integer", Format: "int64"}
-	case pgs.BoolT:
-		return &openapiSchemaObject{Type: "boolean"}
-	case pgs.StringT:
-		return &openapiSchemaObject{Type: "string"}
-	case pgs.MessageT:
-		if wktSchema, ok := wktSchemas[field.Descriptor().GetTypeName()]; ok {
-			return wktSchema
-		}
-		msgTypeName := s.msgTypes[field.Descriptor().GetTypeName()]
-		s.genImportSchemaIfExist(msgTypeName)
-		s.base.Debugf("generate reference schema: %s", field.Descriptor().GetTypeName())
-		return &openapiSchemaObject{
-			Ref: "#/components/schemas/" + s.messageRefName(msgTypeName),
-		}
-
-	case pgs.BytesT:
-		return &openapiSchemaObject{Type: "string", Format: "byte"}
+// genErrorSchema returns the schema of the errors returned by m. Errors listed
+// in the jsonrpc_method option of m are documented as variants of the error
+// object, which does not rule out other errors.
+func (s *Openapi) genErrorSchema(m pgs.Method) *openapiSchemaObject {
+	ref := &openapiSchemaObject{Ref: schemagen.SchemaRefPrefix + errorSchemaName}
+	var opts *proxyoptions.JSONRPCMethod
+	_, err := m.Extension(proxyoptions.E_JsonrpcMethod, &opts)
+	s.base.CheckErr(err, "read jsonrpc_method option of ", m.FullyQualifiedName())
+	if len(opts.GetErrors()) == 0 {
+		return ref
 	}
-	return &openapiSchemaObject{Type: "string"}
-}
-
-func (s *Openapi) genImportSchemaIfExist(fqn string) {
-	// message is imported message and not generated yet
-	if msg, exist := s.importMessages[fqn]; exist && !s.importMessageGen[s.messageRefName(msg.FullyQualifiedName())] {
-		s.importMessageGen[s.messageRefName(msg.FullyQualifiedName())] = true
-		s.schemas[s.messageRefName(msg.FullyQualifiedName())] = s.genMessage(msg)
+	variants := make([]*openapiSchemaObject, 0, len(opts.GetErrors())+1)
+	for _, e := range opts.GetErrors() {
+		title := e.GetReason()
+		if title == "" {
+			title = schemagen.ErrorCodeName(e.GetCode())
+		}
+		variants = append(variants, &openapiSchemaObject{
+			Title:       title,
+			Description: e.GetDescription(),
+			AllOf: []*openapiSchemaObject{
+				ref,
+				{
+					Type: "object",
+					Properties: map[string]*openapiSchemaObject{
+						"code": {Type: "integer", Format: "int32", Enum: []interface{}{e.GetCode()}},
+					},
+				},
+			},
+		})
 	}
+	return &openapiSchemaObject{AnyOf: append(variants, ref)}
 }
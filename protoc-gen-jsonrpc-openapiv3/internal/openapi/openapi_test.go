@@ -0,0 +1,693 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+	"gopkg.in/yaml.v3"
+
+	"github.com/yxlimo/go-jsonrpc-gateway/internal/jsonschema"
+	testproto "github.com/yxlimo/go-jsonrpc-gateway/test/proto"
+	everything "github.com/yxlimo/go-jsonrpc-gateway/test/proto/everything"
+	recursive "github.com/yxlimo/go-jsonrpc-gateway/test/proto/recursive-reference"
+	"github.com/yxlimo/go-jsonrpc-gateway/test/proto/sub"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestGenerateGolden(t *testing.T) {
+	for _, spec := range []struct {
+		file   protoreflect.FileDescriptor
+		params string
+		golden string
+	}{
+		{
+			file:   descriptorSetFile(t, "../../../test/proto/everything/a_bit_of_everything.binpb", "test/proto/everything/a_bit_of_everything.proto"),
+			params: "paths=source_relative",
+			golden: "a_bit_of_everything.openapi.json",
+		},
+		{
+			file:   descriptorSetFile(t, "../../../test/proto/everything/a_bit_of_everything.binpb", "test/proto/everything/a_bit_of_everything.proto"),
+			params: "paths=source_relative,json_names_for_fields=false,enums_as_ints=true",
+			golden: "a_bit_of_everything_proto_names_enums_as_ints.openapi.json",
+		},
+		{
+			// compiled from this directory, the root the files imported
+			// by its templates are found from as protoc runs there
+			file:   descriptorSetFile(t, "testdata/templates.binpb", "testdata/templates.proto"),
+			params: "paths=source_relative,use_go_templates=true",
+			golden: "templates.openapi.json",
+		},
+	} {
+		t.Run(spec.golden, func(t *testing.T) {
+			name := pgs.FilePath(spec.file.Path()).SetExt(".pb.openapi.json").String()
+			golden := filepath.Join("testdata", spec.golden)
+			got := generate(t, []protoreflect.FileDescriptor{spec.file}, spec.params, name)
+			if *update {
+				if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("generated %s differs from %s; run go test -update and review the diff", spec.file.Path(), golden)
+			}
+		})
+	}
+}
+
+func TestImportFile(t *testing.T) {
+	content, err := importFile("testdata/templates.md")
+	if err != nil || !strings.HasPrefix(content, "Greetings are case sensitive") {
+		t.Errorf("importFile(testdata/templates.md) = %q, %v", content, err)
+	}
+	abs, err := filepath.Abs("testdata/templates.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{abs, "../openapi/testdata/templates.md", "testdata/../../openapi.go", ".."} {
+		if _, err := importFile(name); err == nil {
+			t.Errorf("importFile(%s) reads a file out of the source root", name)
+		}
+	}
+}
+
+func TestGenerateMerge(t *testing.T) {
+	files := []protoreflect.FileDescriptor{
+		everything.File_test_proto_everything_a_bit_of_everything_proto,
+		testproto.File_test_proto_hello_proto,
+	}
+	got := generate(t, files, "allow_merge=true,merge_file_name=api", "api.openapi.json")
+	var doc openapiObject
+	if err := json.Unmarshal(got, &doc); err != nil {
+		t.Fatal(err)
+	}
+	// the openapiv3_document option of a_bit_of_everything.proto titles the
+	// merged document instead of merge_file_name
+	if doc.Info.Title != "A Bit of Everything" {
+		t.Errorf("title = %q; want %q", doc.Info.Title, "A Bit of Everything")
+	}
+	for _, path := range []string{"/create", "/hello", "/send_my_gift"} {
+		if _, ok := doc.Paths[path]; !ok {
+			t.Errorf("merged document has no path %s", path)
+		}
+	}
+	for _, schema := range []string{"everything.ABitOfEverything", "proto.HelloRequest"} {
+		if _, ok := doc.Components.Schemas[schema]; !ok {
+			t.Errorf("merged document has no schema %s", schema)
+		}
+	}
+	// the order of the files to generate does not matter
+	files[0], files[1] = files[1], files[0]
+	if again := generate(t, files, "allow_merge=true,merge_file_name=api", "api.openapi.json"); !bytes.Equal(got, again) {
+		t.Errorf("merged document depends on the order of the files to generate")
+	}
+}
+
+func TestGeneratePerFile(t *testing.T) {
+	files := []protoreflect.FileDescriptor{
+		everything.File_test_proto_everything_a_bit_of_everything_proto,
+		testproto.File_test_proto_hello_proto,
+	}
+	got := generate(t, files, "paths=source_relative", "test/proto/hello.pb.openapi.json")
+	var doc openapiObject
+	if err := json.Unmarshal(got, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := doc.Paths["/create"]; ok {
+		t.Errorf("document of hello.proto has paths of a_bit_of_everything.proto")
+	}
+	for name := range doc.Components.Schemas {
+		if strings.HasPrefix(name, "everything.") {
+			t.Errorf("document of hello.proto has schema %s of a_bit_of_everything.proto", name)
+		}
+	}
+}
+
+func TestGeneratePerFileWithoutServices(t *testing.T) {
+	files := []protoreflect.FileDescriptor{sub.File_test_proto_sub_message_proto}
+	got := generate(t, files, "paths=source_relative", "test/proto/sub/message.pb.openapi.json")
+	var doc openapiObject
+	if err := json.Unmarshal(got, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Paths) != 0 {
+		t.Errorf("document of message.proto has paths %v", doc.Paths)
+	}
+	if _, ok := doc.Components.Schemas["sub.StringMessage"]; !ok {
+		t.Errorf("document of message.proto has no schema sub.StringMessage")
+	}
+}
+
+func TestGenerateIndent(t *testing.T) {
+	files := []protoreflect.FileDescriptor{everything.File_test_proto_everything_a_bit_of_everything_proto}
+	want := generate(t, files, "paths=source_relative", "test/proto/everything/a_bit_of_everything.pb.openapi.json")
+	got := render(t, files, "paths=source_relative,indent=2", "test/proto/everything/a_bit_of_everything.pb.openapi.json")
+	if !bytes.Equal(got, want) {
+		t.Errorf("document indented by the plugin differs from the document indented by json.Indent")
+	}
+}
+
+func TestInitContextInvalidIndent(t *testing.T) {
+	for _, params := range []string{"indent=-1", "indent=two"} {
+		d := pgs.InitMockDebugger()
+		New().InitContext(pgs.Context(d, pgs.ParseParameters(params), "."))
+		if !d.Exited() || d.ExitCode() == 0 {
+			t.Errorf("%s: plugin did not fail", params)
+		}
+	}
+}
+
+func TestGenerateYAML(t *testing.T) {
+	files := []protoreflect.FileDescriptor{everything.File_test_proto_everything_a_bit_of_everything_proto}
+	content := render(t, files, "paths=source_relative", "test/proto/everything/a_bit_of_everything.pb.openapi.json")
+	var want interface{}
+	if err := json.Unmarshal(content, &want); err != nil {
+		t.Fatal(err)
+	}
+	content = render(t, files, "paths=source_relative,output_format=yaml", "test/proto/everything/a_bit_of_everything.pb.openapi.yaml")
+	var doc interface{}
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		t.Fatal(err)
+	}
+	// compare through JSON, which has a single number type
+	content, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got interface{}
+	if err := json.Unmarshal(content, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("YAML document differs from JSON document")
+	}
+}
+
+// generate runs the module on files as protoc would and returns the indented
+// OpenAPI document it writes to name.
+func generate(t *testing.T, files []protoreflect.FileDescriptor, params string, name string) []byte {
+	content := render(t, files, params, name)
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, content, "", "  "); err != nil {
+		t.Fatal(err)
+	}
+	indented.WriteByte('\n')
+	return indented.Bytes()
+}
+
+// descriptorSetFile returns the file path of the descriptor set name. Unlike
+// the descriptors registered by protoc-gen-go, the sets keep the source info,
+// so the documents generated from them have comments.
+func descriptorSetFile(t *testing.T, name, path string) protoreflect.FileDescriptor {
+	content, err := ioutil.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(content, &set); err != nil {
+		t.Fatal(err)
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := files.FindFileByPath(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+// render runs the module on files as protoc would and returns the document it
+// writes to name.
+func render(t *testing.T, files []protoreflect.FileDescriptor, params string, name string) []byte {
+	req := &pluginpb.CodeGeneratorRequest{
+		Parameter: proto.String(params),
+	}
+	seen := make(map[string]bool)
+	for _, file := range files {
+		req.FileToGenerate = append(req.FileToGenerate, file.Path())
+		req.ProtoFile = withImports(file, req.ProtoFile, seen)
+	}
+	in, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs := afero.NewMemMapFs()
+	var out bytes.Buffer
+	pgs.Init(pgs.ProtocInput(bytes.NewReader(in)), pgs.ProtocOutput(&out), pgs.FileSystem(fs)).
+		RegisterModule(New()).Render()
+
+	content, err := afero.ReadFile(fs, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+// withImports appends file after its transitive imports to files, in the
+// topological order protoc uses.
+func withImports(file protoreflect.FileDescriptor, files []*descriptorpb.FileDescriptorProto, seen map[string]bool) []*descriptorpb.FileDescriptorProto {
+	if seen[file.Path()] {
+		return files
+	}
+	seen[file.Path()] = true
+	imports := file.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		files = withImports(imports.Get(i).FileDescriptor, files, seen)
+	}
+	return append(files, protodesc.ToFileDescriptorProto(file))
+}
+
+func TestGenerateMetadata(t *testing.T) {
+	files := []protoreflect.FileDescriptor{testproto.File_test_proto_hello_proto}
+	params := "paths=source_relative,openapi_configuration=testdata/openapi_configuration.json,version=1.2.0,description=Greetings"
+	got := generate(t, files, params, "test/proto/hello.pb.openapi.json")
+	var doc openapiObject
+	if err := json.Unmarshal(got, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.Info.Title != "Hello API" || doc.Info.Version != "1.2.0" || doc.Info.Description != "Greetings" {
+		t.Errorf("info = %+v; want title, version and description of the parameters", doc.Info)
+	}
+	if doc.Info.Contact == nil || doc.Info.Contact.Email != "gateway@example.com" {
+		t.Errorf("contact = %+v; want gateway@example.com", doc.Info.Contact)
+	}
+	if len(doc.Servers) != 1 || doc.Servers[0].URL != "https://api.example.com/jsonrpc" {
+		t.Errorf("servers = %+v; want https://api.example.com/jsonrpc", doc.Servers)
+	}
+	if scheme := doc.Components.SecuritySchemes["bearer"]; scheme == nil || scheme.Type != "http" || scheme.Scheme != "bearer" {
+		t.Errorf("security scheme bearer = %+v; want http bearer", scheme)
+	}
+	if len(doc.Security) != 1 || doc.Security[0]["bearer"] == nil {
+		t.Errorf("security = %+v; want bearer with no scopes", doc.Security)
+	}
+	if doc.ExternalDocs == nil || doc.ExternalDocs.URL != "https://example.com/docs" {
+		t.Errorf("external docs = %+v; want https://example.com/docs", doc.ExternalDocs)
+	}
+}
+
+func TestGenerateMetadataReplacesFileOptions(t *testing.T) {
+	files := []protoreflect.FileDescriptor{everything.File_test_proto_everything_a_bit_of_everything_proto}
+	params := "paths=source_relative,openapi_configuration=testdata/openapi_configuration.json"
+	got := generate(t, files, params, "test/proto/everything/a_bit_of_everything.pb.openapi.json")
+	var doc openapiObject
+	if err := json.Unmarshal(got, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Servers) != 1 || doc.Servers[0].URL != "https://api.example.com/jsonrpc" {
+		t.Errorf("servers = %+v; want only https://api.example.com/jsonrpc of the parameters", doc.Servers)
+	}
+	if len(doc.Security) != 1 || doc.Security[0]["bearer"] == nil {
+		t.Errorf("security = %+v; want only bearer of the parameters", doc.Security)
+	}
+	if scheme := doc.Components.SecuritySchemes["ApiKeyAuth"]; scheme == nil {
+		t.Errorf("security scheme ApiKeyAuth of the file option is missing")
+	}
+	scheme := doc.Components.SecuritySchemes["oauth"]
+	if scheme == nil || scheme.Type != "oauth2" || scheme.Flows == nil || scheme.Flows.AuthorizationCode == nil {
+		t.Fatalf("security scheme oauth = %+v; want oauth2 with an authorization code flow", scheme)
+	}
+	flow := scheme.Flows.AuthorizationCode
+	if flow.TokenURL != "https://example.com/oauth/token" || flow.Scopes["read"] != "read access" {
+		t.Errorf("authorization code flow = %+v; want the token URL and scopes of the parameters", flow)
+	}
+}
+
+func TestGenerateTagsWithPackage(t *testing.T) {
+	files := []protoreflect.FileDescriptor{testproto.File_test_proto_hello_proto}
+	got := generate(t, files, "paths=source_relative,include_package_in_tags=true", "test/proto/hello.pb.openapi.json")
+	var doc openapiObject
+	if err := json.Unmarshal(got, &doc); err != nil {
+		t.Fatal(err)
+	}
+	operation := doc.Paths["/hello"].Post
+	if len(operation.Tags) != 1 || operation.Tags[0] != "proto.Greet" {
+		t.Errorf("tags = %v; want [proto.Greet]", operation.Tags)
+	}
+	if operation.OperationID != "Hello" {
+		t.Errorf("operationId = %q; want %q", operation.OperationID, "Hello")
+	}
+	var names []string
+	for _, tag := range doc.Tags {
+		names = append(names, tag.Name)
+	}
+	if want := []string{"proto.AnotherServiceWithNoBindings", "proto.Greet"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("document tags = %v; want %v", names, want)
+	}
+}
+
+func TestGenerateStreaming(t *testing.T) {
+	files := []protoreflect.FileDescriptor{testproto.File_test_proto_hello_proto}
+	for _, spec := range []struct {
+		params string
+		served bool
+	}{
+		{params: "paths=source_relative", served: false},
+		{params: "paths=source_relative,streaming_transport=true", served: true},
+	} {
+		got := generate(t, files, spec.params, "test/proto/hello.pb.openapi.json")
+		var doc openapiObject
+		if err := json.Unmarshal(got, &doc); err != nil {
+			t.Fatal(err)
+		}
+		path, ok := doc.Paths["/hello_stream"]
+		if ok != spec.served {
+			t.Fatalf("%s: streaming method documented = %t; want %t", spec.params, ok, spec.served)
+		}
+		if !ok {
+			continue
+		}
+		if !strings.Contains(path.Post.Description, streamingNote) {
+			t.Errorf("description = %q; want to note that streaming is not served yet", path.Post.Description)
+		}
+		if path.Post.Streaming != "server" {
+			t.Errorf("x-jsonrpc-streaming = %q; want %q", path.Post.Streaming, "server")
+		}
+		params := path.Post.Notification.Properties["params"]
+		if ref := params.Properties["result"].Ref; ref != "#/components/schemas/proto.HelloResponse" {
+			t.Errorf("notification result = %q; want a reference to proto.HelloResponse", ref)
+		}
+		if result := path.Post.Responses["200"].Content["application/json"].Schema.Properties["result"]; result.Type != "string" {
+			t.Errorf("result type = %q; want the subscription id", result.Type)
+		}
+	}
+}
+
+func TestGenerateVisibility(t *testing.T) {
+	files := []protoreflect.FileDescriptor{everything.File_test_proto_everything_a_bit_of_everything_proto}
+	for _, spec := range []struct {
+		selectors string
+		internal  bool
+		preview   bool
+	}{
+		{},
+		{selectors: "INTERNAL", internal: true},
+		{selectors: "INTERNAL;PREVIEW", internal: true, preview: true},
+	} {
+		params := "paths=source_relative,visibility_restriction_selectors=" + spec.selectors
+		got := generate(t, files, params, "test/proto/everything/a_bit_of_everything.pb.openapi.json")
+		var doc openapiObject
+		if err := json.Unmarshal(got, &doc); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := doc.Paths["/internal_only"]; ok != spec.internal {
+			t.Errorf("selectors %q: method InternalOnly documented = %t; want %t", spec.selectors, ok, spec.internal)
+		}
+		properties := doc.Components.Schemas["everything.ABitOfEverything"].Properties
+		if _, ok := properties["internalStringValue"]; ok != spec.internal {
+			t.Errorf("selectors %q: field internal_string_value documented = %t; want %t", spec.selectors, ok, spec.internal)
+		}
+		values := doc.Components.Schemas["everything.NumericEnum"].Enum
+		if preview := len(values) == 3; preview != spec.preview {
+			t.Errorf("selectors %q: enum values = %v; want TWO documented = %t", spec.selectors, values, spec.preview)
+		}
+	}
+}
+
+func TestGenerateNestedOutputOnly(t *testing.T) {
+	files := []protoreflect.FileDescriptor{everything.File_test_proto_everything_a_bit_of_everything_proto}
+	got := generate(t, files, "paths=source_relative", "test/proto/everything/a_bit_of_everything.pb.openapi.json")
+	var doc openapiObject
+	if err := json.Unmarshal(got, &doc); err != nil {
+		t.Fatal(err)
+	}
+	// the request refers to the component schema of the book, whose output
+	// only fields must be marked for clients not to send them
+	request := doc.Components.Schemas["everything.CreateBookRequest"]
+	if book := request.Properties["book"]; book == nil || book.Ref != "#/components/schemas/everything.Book" {
+		t.Fatalf("CreateBookRequest.book = %+v; want a reference to everything.Book", book)
+	}
+	properties := doc.Components.Schemas["everything.Book"].Properties
+	for _, name := range []string{"id", "createTime"} {
+		if property := properties[name]; property == nil || !property.ReadOnly {
+			t.Errorf("Book.%s = %+v; want readOnly", name, property)
+		}
+	}
+	if properties["name"].ReadOnly {
+		t.Error("Book.name is readOnly")
+	}
+}
+
+func TestGenerateFieldNames(t *testing.T) {
+	files := []protoreflect.FileDescriptor{everything.File_test_proto_everything_a_bit_of_everything_proto}
+	for _, spec := range []struct {
+		params string
+		names  []string
+	}{
+		{names: []string{"int32Value", "optionalStringValue", "nonConventionalNameValue"}},
+		{params: ",json_names_for_fields=false", names: []string{"int32_value", "optional_string_value", "nonConventionalNameValue"}},
+	} {
+		got := generate(t, files, "paths=source_relative"+spec.params, "test/proto/everything/a_bit_of_everything.pb.openapi.json")
+		var doc openapiObject
+		if err := json.Unmarshal(got, &doc); err != nil {
+			t.Fatal(err)
+		}
+		properties := doc.Components.Schemas["everything.ABitOfEverything"].Properties
+		for _, name := range spec.names {
+			if _, ok := properties[name]; !ok {
+				t.Errorf("params %q: ABitOfEverything has no property %s", spec.params, name)
+			}
+		}
+	}
+}
+
+func TestGenerateOneOf(t *testing.T) {
+	files := []protoreflect.FileDescriptor{everything.File_test_proto_everything_a_bit_of_everything_proto}
+	got := generate(t, files, "paths=source_relative", "test/proto/everything/a_bit_of_everything.pb.openapi.json")
+	var doc openapiObject
+	if err := json.Unmarshal(got, &doc); err != nil {
+		t.Fatal(err)
+	}
+	schema := doc.Components.Schemas["everything.ABitOfEverything"]
+	var titles []string
+	for _, alternative := range schema.OneOf {
+		titles = append(titles, alternative.Title)
+	}
+	if want := []string{"oneofEmpty", "oneofString", "none of oneof_value"}; !reflect.DeepEqual(titles, want) {
+		t.Fatalf("oneOf alternatives = %v; want %v", titles, want)
+	}
+	if required := schema.OneOf[1].Required; !reflect.DeepEqual(required, []string{"oneofString"}) {
+		t.Errorf("alternative oneofString requires %v", required)
+	}
+	if none := schema.OneOf[2].Not; none == nil || len(none.AnyOf) != 2 {
+		t.Errorf("alternative none of oneof_value excludes %+v; want both members", none)
+	}
+	want := &jsonschema.Discriminator{
+		OneOf: "oneof_value",
+		Mapping: map[string]string{
+			"oneofEmpty":  "#/components/schemas/everything.ABitOfEverything/properties/oneofEmpty",
+			"oneofString": "#/components/schemas/everything.ABitOfEverything/properties/oneofString",
+		},
+	}
+	if !reflect.DeepEqual(schema.Discriminator, want) {
+		t.Errorf("discriminator = %+v; want %+v", schema.Discriminator, want)
+	}
+	for member := range want.Mapping {
+		if _, ok := schema.Properties[member]; !ok {
+			t.Errorf("discriminator maps %s to a missing property", member)
+		}
+	}
+}
+
+func TestGenerateFieldPresence(t *testing.T) {
+	files := []protoreflect.FileDescriptor{everything.File_test_proto_everything_a_bit_of_everything_proto}
+	for _, spec := range []struct {
+		params   string
+		nullable bool
+	}{
+		{},
+		{params: ",proto3_optional_nullable=true", nullable: true},
+	} {
+		got := generate(t, files, "paths=source_relative"+spec.params, "test/proto/everything/a_bit_of_everything.pb.openapi.json")
+		var doc openapiObject
+		if err := json.Unmarshal(got, &doc); err != nil {
+			t.Fatal(err)
+		}
+		properties := doc.Components.Schemas["everything.ABitOfEverything"].Properties
+		for _, name := range []string{"optionalStringValue", "wrappedStringValue", "wrappedInt64Value"} {
+			if properties[name].Nullable != spec.nullable {
+				t.Errorf("params %q: %s nullable = %t; want %t", spec.params, name, properties[name].Nullable, spec.nullable)
+			}
+		}
+		for name, def := range map[string]string{"stringValue": `""`, "int32Value": "0", "boolValue": "false"} {
+			property := properties[name]
+			if property.Nullable {
+				t.Errorf("params %q: %s is nullable", spec.params, name)
+			}
+			if got := string(property.Default); spec.nullable && got != def {
+				t.Errorf("params %q: %s default = %s; want %s", spec.params, name, got, def)
+			} else if !spec.nullable && got != "" {
+				t.Errorf("params %q: %s default = %s; want none", spec.params, name, got)
+			}
+		}
+		if properties["durationValue"].Nullable {
+			t.Errorf("params %q: embedded message durationValue is nullable", spec.params)
+		}
+	}
+}
+
+func TestGenerateEnums(t *testing.T) {
+	files := []protoreflect.FileDescriptor{everything.File_test_proto_everything_a_bit_of_everything_proto}
+	for _, spec := range []struct {
+		params string
+		typ    string
+		values []interface{}
+		def    string
+		// omitted are the values singular fields leave out
+		omitted []interface{}
+	}{
+		{
+			params: "paths=source_relative",
+			typ:    "string",
+			values: []interface{}{"ZERO", "ONE"},
+			def:    `"ZERO"`,
+		},
+		{
+			params: "paths=source_relative,enums_as_ints=true",
+			typ:    "integer",
+			values: []interface{}{0.0, 1.0},
+			def:    "0",
+		},
+		{
+			params:  "paths=source_relative,omit_enum_default_value=true",
+			typ:     "string",
+			values:  []interface{}{"ZERO", "ONE"},
+			omitted: []interface{}{"ZERO"},
+		},
+	} {
+		// the defaults of fields are documented with proto3_optional_nullable
+		got := generate(t, files, spec.params+",proto3_optional_nullable=true", "test/proto/everything/a_bit_of_everything.pb.openapi.json")
+		var doc openapiObject
+		if err := json.Unmarshal(got, &doc); err != nil {
+			t.Fatal(err)
+		}
+		enum, ok := doc.Components.Schemas["everything.NumericEnum"]
+		if !ok {
+			t.Fatalf("%s: enum NumericEnum is not a component", spec.params)
+		}
+		if enum.Type != spec.typ || !reflect.DeepEqual(enum.Enum, spec.values) {
+			t.Errorf("%s: enum = %s %v; want %s %v", spec.params, enum.Type, enum.Enum, spec.typ, spec.values)
+		}
+		properties := doc.Components.Schemas["everything.ABitOfEverything"].Properties
+		property := properties["enumValue"]
+		var refs []string
+		var omitted []interface{}
+		for _, schema := range append([]*openapiSchemaObject{property}, property.AllOf...) {
+			if schema.Ref != "" {
+				refs = append(refs, schema.Ref)
+			}
+			if schema.Not != nil {
+				omitted = append(omitted, schema.Not.Enum...)
+			}
+		}
+		if !reflect.DeepEqual(refs, []string{"#/components/schemas/everything.NumericEnum"}) {
+			t.Errorf("%s: enumValue = %+v; want a reference to the enum", spec.params, property)
+		}
+		if !reflect.DeepEqual(omitted, spec.omitted) {
+			t.Errorf("%s: enumValue leaves out %v; want %v", spec.params, omitted, spec.omitted)
+		}
+		if string(property.Default) != spec.def {
+			t.Errorf("%s: enumValue default = %s; want %s", spec.params, property.Default, spec.def)
+		}
+		// repeated fields encode the zero value like any other
+		if items := properties["repeatedEnumValue"].Items; items == nil || items.Ref != "#/components/schemas/everything.NumericEnum" {
+			t.Errorf("%s: repeatedEnumValue items = %+v; want a reference to the enum", spec.params, items)
+		}
+	}
+}
+
+func TestGenerateReferencesResolve(t *testing.T) {
+	files := []protoreflect.FileDescriptor{
+		everything.File_test_proto_everything_a_bit_of_everything_proto,
+		testproto.File_test_proto_hello_proto,
+		recursive.File_test_proto_recursive_reference_recursive_service_proto,
+		recursive.File_test_proto_recursive_reference_tree_service_proto,
+	}
+	documents := map[string][]byte{
+		"api.openapi.json": generate(t, files, "allow_merge=true,merge_file_name=api", "api.openapi.json"),
+	}
+	for _, file := range files {
+		name := pgs.FilePath(file.Path()).SetExt(".pb.openapi.json").String()
+		for _, params := range []string{"paths=source_relative", "paths=source_relative,enums_as_ints=true,visibility_restriction_selectors=INTERNAL"} {
+			documents[name+" "+params] = generate(t, []protoreflect.FileDescriptor{file}, params, name)
+		}
+	}
+	// and the documents checked in, generated by protoc
+	for _, pattern := range []string{"testdata/*.openapi.json", "../../../test/proto/*.openapi.json", "../../../test/proto/*/*.openapi.json"} {
+		names, err := filepath.Glob(pattern)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range names {
+			content, err := ioutil.ReadFile(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			documents[name] = content
+		}
+	}
+	for name, content := range documents {
+		var doc map[string]interface{}
+		if err := json.Unmarshal(content, &doc); err != nil {
+			t.Fatal(err)
+		}
+		schemas := doc["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+		for _, ref := range refs(doc, nil) {
+			if !strings.HasPrefix(ref, "#/components/schemas/") {
+				t.Errorf("%s: unexpected reference %s", name, ref)
+				continue
+			}
+			if schema, ok := schemas[strings.TrimPrefix(ref, "#/components/schemas/")]; !ok || schema == nil {
+				t.Errorf("%s: reference %s does not resolve", name, ref)
+			}
+		}
+	}
+	// the nested, map value and recursive messages of the imported file
+	got := generate(t, files[3:], "paths=source_relative", "test/proto/recursive-reference/tree_service.pb.openapi.json")
+	var doc openapiObject
+	if err := json.Unmarshal(got, &doc); err != nil {
+		t.Fatal(err)
+	}
+	for _, schema := range []string{"recursive_reference.Tree", "Tree.Node", "recursive_reference.Foo", "recursive_reference.Bar"} {
+		if _, ok := doc.Components.Schemas[schema]; !ok {
+			t.Errorf("document has no schema %s", schema)
+		}
+	}
+}
+
+// refs appends the $ref values found in the decoded JSON v to found.
+func refs(v interface{}, found []string) []string {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if ref, ok := value.(string); ok && key == "$ref" {
+				found = append(found, ref)
+				continue
+			}
+			found = refs(value, found)
+		}
+	case []interface{}:
+		for _, value := range v {
+			found = refs(value, found)
+		}
+	}
+	return found
+}
@@ -0,0 +1,71 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats of the generated documents.
+const (
+	formatJSON = "json"
+	formatYAML = "yaml"
+)
+
+// encode serializes the document in the output format. Object keys keep the
+// order of the OpenAPI specification, and maps are sorted by key, so that
+// checked-in documents only change with their content.
+func (s *Openapi) encode(object *openapiObject) ([]byte, error) {
+	content, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	if s.outputFormat == formatYAML {
+		return jsonToYAML(content, s.indent)
+	}
+	if s.indent == 0 {
+		return content, nil
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, content, "", strings.Repeat(" ", s.indent)); err != nil {
+		return nil, err
+	}
+	indented.WriteByte('\n')
+	return indented.Bytes(), nil
+}
+
+// jsonToYAML converts a JSON document to YAML in block style, preserving the
+// order of keys.
+func jsonToYAML(content []byte, indent int) ([]byte, error) {
+	// JSON is YAML in flow style, so decoding it into a node keeps the keys
+	// in order
+	var node yaml.Node
+	if err := yaml.Unmarshal(content, &node); err != nil {
+		return nil, err
+	}
+	blockStyle(&node)
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	if indent == 0 {
+		indent = 2
+	}
+	encoder.SetIndent(indent)
+	if err := encoder.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// blockStyle drops the flow style and the quotes of node and its children. The
+// encoder still quotes strings which would otherwise be read as another type.
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
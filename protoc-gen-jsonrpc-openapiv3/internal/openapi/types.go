@@ -1,16 +1,76 @@
 package openapi
 
+import "github.com/yxlimo/go-jsonrpc-gateway/internal/jsonschema"
+
 type openapiObject struct {
-	Version    string                        `json:"openapi"`
-	Info       openapiInfoObject             `json:"info"`
-	Paths      map[string]*openapiPathObject `json:"paths"`
-	Components openapiComponentsObject       `json:"components,omitempty"`
+	Version      string                              `json:"openapi"`
+	Info         openapiInfoObject                   `json:"info"`
+	Servers      []*openapiServerObject              `json:"servers,omitempty"`
+	Paths        map[string]*openapiPathObject       `json:"paths"`
+	Components   openapiComponentsObject             `json:"components,omitempty"`
+	Security     []map[string][]string               `json:"security,omitempty"`
+	Tags         []*openapiTagObject                 `json:"tags,omitempty"`
+	ExternalDocs *openapiExternalDocumentationObject `json:"externalDocs,omitempty"`
 }
 
 type openapiInfoObject struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Version     string `json:"version"`
+	Title          string                `json:"title"`
+	Description    string                `json:"description"`
+	TermsOfService string                `json:"termsOfService,omitempty"`
+	Contact        *openapiContactObject `json:"contact,omitempty"`
+	License        *openapiLicenseObject `json:"license,omitempty"`
+	Version        string                `json:"version"`
+}
+
+type openapiContactObject struct {
+	Name  string `json:"name,omitempty"`
+	URL   string `json:"url,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+type openapiLicenseObject struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+type openapiServerObject struct {
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+type openapiExternalDocumentationObject struct {
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url"`
+}
+
+type openapiSecuritySchemeObject struct {
+	Type             string                   `json:"type"`
+	Description      string                   `json:"description,omitempty"`
+	Name             string                   `json:"name,omitempty"`
+	In               string                   `json:"in,omitempty"`
+	Scheme           string                   `json:"scheme,omitempty"`
+	BearerFormat     string                   `json:"bearerFormat,omitempty"`
+	OpenIDConnectURL string                   `json:"openIdConnectUrl,omitempty"`
+	Flows            *openapiOAuthFlowsObject `json:"flows,omitempty"`
+}
+
+type openapiOAuthFlowsObject struct {
+	Implicit          *openapiOAuthFlowObject `json:"implicit,omitempty"`
+	Password          *openapiOAuthFlowObject `json:"password,omitempty"`
+	ClientCredentials *openapiOAuthFlowObject `json:"clientCredentials,omitempty"`
+	AuthorizationCode *openapiOAuthFlowObject `json:"authorizationCode,omitempty"`
+}
+
+type openapiOAuthFlowObject struct {
+	AuthorizationURL string            `json:"authorizationUrl,omitempty"`
+	TokenURL         string            `json:"tokenUrl,omitempty"`
+	RefreshURL       string            `json:"refreshUrl,omitempty"`
+	Scopes           map[string]string `json:"scopes"`
+}
+
+type openapiTagObject struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
 }
 
 type openapiPathObject struct {
@@ -18,9 +78,18 @@ type openapiPathObject struct {
 }
 
 type openapiOperationObject struct {
+	Tags        []string                          `json:"tags,omitempty"`
 	Summary     string                            `json:"summary,omitempty"`
+	Description string                            `json:"description,omitempty"`
+	OperationID string                            `json:"operationId,omitempty"`
 	RequestBody *openapiRequestBodyObject         `json:"requestBody"`
 	Responses   map[string]*openapiResponseObject `json:"responses"`
+	Deprecated  bool                              `json:"deprecated,omitempty"`
+	// Streaming is server, client or bidi for streaming methods
+	Streaming string `json:"x-jsonrpc-streaming,omitempty"`
+	// Notification is the schema of the notifications sent to subscribers of
+	// streaming methods
+	Notification *openapiSchemaObject `json:"x-jsonrpc-notification,omitempty"`
 }
 
 type openapiRequestBodyObject struct {
@@ -36,21 +105,11 @@ type openapiMediaTypeObject struct {
 	Schema *openapiSchemaObject `json:"schema"`
 }
 
-type openapiSchemaObject struct {
-	Ref                  string                          `json:"$ref,omitempty"`
-	OneOf                []*openapiSchemaObject          `json:"oneOf,omitempty"`
-	Type                 string                          `json:"type,omitempty"`
-	Nullable             bool                            `json:"nullable,omitempty"`
-	Enum                 []string                        `json:"enum,omitempty"`
-	Items                *openapiSchemaObject            `json:"items,omitempty"`
-	Properties           map[string]*openapiSchemaObject `json:"properties,omitempty"`
-	AdditionalProperties *openapiSchemaObject            `json:"additionalProperties,omitempty"`
-	Format               string                          `json:"format,omitempty"`
-	Minimum              float64                         `json:"minimum,omitempty"`
-	Required             []string                        `json:"required,omitempty"`
-	Pattern              string                          `json:"pattern,omitempty"`
-}
+// openapiSchemaObject is shared with OpenRPC documents, whose schemas describe
+// messages the same way.
+type openapiSchemaObject = jsonschema.Schema
 
 type openapiComponentsObject struct {
-	Schemas map[string]*openapiSchemaObject `json:"schemas"`
+	Schemas         map[string]*openapiSchemaObject         `json:"schemas"`
+	SecuritySchemes map[string]*openapiSecuritySchemeObject `json:"securitySchemes,omitempty"`
 }
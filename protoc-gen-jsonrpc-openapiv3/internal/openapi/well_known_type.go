@@ -1,69 +1,10 @@
 package openapi
 
-var wktSchemas = map[string]*openapiSchemaObject{
-	".google.protobuf.FieldMask": {
-		Type: "string",
-	},
-	".google.protobuf.Timestamp": {
-		Type:   "string",
-		Format: "date-time",
-	},
-	".google.protobuf.Duration": {
-		Type: "string",
-	},
-	".google.protobuf.StringValue": {
-		Type: "string",
-	},
-	".google.protobuf.BytesValue": {
-		Type:   "string",
-		Format: "byte",
-	},
-	".google.protobuf.Int32Value": {
-		Type:   "integer",
-		Format: "int32",
-	},
-	".google.protobuf.UInt32Value": {
-		Type:   "integer",
-		Format: "int64",
-	},
-	".google.protobuf.Int64Value": {
-		Type:   "string",
-		Format: "int64",
-	},
-	".google.protobuf.UInt64Value": {
-		Type:   "string",
-		Format: "uint64",
-	},
-	".google.protobuf.FloatValue": {
-		Type:   "number",
-		Format: "float",
-	},
-	".google.protobuf.DoubleValue": {
-		Type:   "number",
-		Format: "double",
-	},
-	".google.protobuf.BoolValue": {
-		Type: "boolean",
-	},
-	".google.protobuf.Empty": {
-		Type: "object",
-	},
-	".google.protobuf.Struct": {
-		Type: "object",
-	},
-	".google.protobuf.Value": {
-		Type: "object",
-	},
-	".google.protobuf.ListValue": {
-		Type: "array",
-		Items: &openapiSchemaObject{
-			Type: "object",
-		},
-	},
-	".google.protobuf.NullValue": {
-		Type: "string",
-	},
-}
+import (
+	"fmt"
+
+	"github.com/yxlimo/go-jsonrpc-gateway/jsonrpc"
+)
 
 func (s *Openapi) jsonrpcRequestSchema(method string, req *openapiSchemaObject) *openapiRequestBodyObject {
 	return &openapiRequestBodyObject{
@@ -71,7 +12,7 @@ func (s *Openapi) jsonrpcRequestSchema(method string, req *openapiSchemaObject)
 			"application/json": {Schema: &openapiSchemaObject{
 				Type: "object",
 				Properties: map[string]*openapiSchemaObject{
-					"jsonrpc": {Type: "string", Enum: []string{"2.0"}},
+					"jsonrpc": {Type: "string", Enum: []interface{}{"2.0"}},
 					"method": {
 						Type:    "string",
 						Pattern: "^" + method + "$",
@@ -85,15 +26,14 @@ func (s *Openapi) jsonrpcRequestSchema(method string, req *openapiSchemaObject)
 	}
 }
 
-func (s *Openapi) jsonrpcResponseSchema(method string, res *openapiSchemaObject) *openapiResponseObject {
+func (s *Openapi) jsonrpcResponseSchema(res *openapiSchemaObject) *openapiResponseObject {
 	return &openapiResponseObject{
 		Content: map[string]*openapiMediaTypeObject{
 			"application/json": {
 				Schema: &openapiSchemaObject{
 					Type: "object",
 					Properties: map[string]*openapiSchemaObject{
-						"jsonrpc": {Type: "string", Enum: []string{"2.0"}},
-						"method":  {Type: "string", Pattern: "^" + method + "$"},
+						"jsonrpc": {Type: "string", Enum: []interface{}{"2.0"}},
 						"result":  res,
 						"id":      {Type: "string"},
 					},
@@ -103,3 +43,87 @@ func (s *Openapi) jsonrpcResponseSchema(method string, res *openapiSchemaObject)
 		},
 	}
 }
+
+func (s *Openapi) jsonrpcErrorResponseSchema(err *openapiSchemaObject) *openapiResponseObject {
+	return &openapiResponseObject{
+		Description: "JSON-RPC error",
+		Content: map[string]*openapiMediaTypeObject{
+			"application/json": {
+				Schema: &openapiSchemaObject{
+					Type: "object",
+					Properties: map[string]*openapiSchemaObject{
+						"jsonrpc": {Type: "string", Enum: []interface{}{"2.0"}},
+						"error":   err,
+						"id":      {Type: "string"},
+					},
+					Required: []string{"error"},
+				},
+			},
+		},
+	}
+}
+
+// notificationMethodSuffix is appended to the name of a streaming method to
+// name its notifications.
+const notificationMethodSuffix = "_subscription"
+
+// streamingNote is added to the description of streaming methods, whose
+// subscription protocol is not served by jsonrpc.ServeMux yet.
+const streamingNote = "Streaming method: jsonrpc.ServeMux does not serve streaming methods yet and answers them " +
+	"with Unimplemented. The subscription protocol documented here is the one planned for a streaming transport."
+
+// subscriptionSchema is the result of a call to a streaming method, the id of
+// the subscription its messages are sent to.
+var subscriptionSchema = &openapiSchemaObject{
+	Type:        "string",
+	Description: "Subscription id, sent with every notification of the subscription.",
+}
+
+// jsonrpcNotificationSchema returns the schema of the notifications carrying
+// the messages res of a subscription to the streaming method.
+func (s *Openapi) jsonrpcNotificationSchema(method string, res *openapiSchemaObject) *openapiSchemaObject {
+	return &openapiSchemaObject{
+		Type: "object",
+		Properties: map[string]*openapiSchemaObject{
+			"jsonrpc": {Type: "string", Enum: []interface{}{"2.0"}},
+			"method": {
+				Type:    "string",
+				Pattern: "^" + method + notificationMethodSuffix + "$",
+			},
+			"params": {
+				Type: "object",
+				Properties: map[string]*openapiSchemaObject{
+					"subscription": {Type: "string"},
+					"result":       res,
+				},
+				Required: []string{"subscription", "result"},
+			},
+		},
+		Required: []string{"jsonrpc", "method", "params"},
+	}
+}
+
+// errorSchemaName is the name of the component describing JSON-RPC errors.
+const errorSchemaName = "jsonrpc.Error"
+
+var errorSchema = &openapiSchemaObject{
+	Type: "object",
+	Description: fmt.Sprintf("JSON-RPC error object. The code is the gRPC status code of the error, "+
+		"except for DEADLINE_EXCEEDED reported as %d and requests failing validation reported as %d, "+
+		"and data holds the details of its google.rpc.Status.", jsonrpc.DeadlineExceededErrorCode, jsonrpc.InvalidParamsErrorCode),
+	Properties: map[string]*openapiSchemaObject{
+		"code":    {Type: "integer", Format: "int32"},
+		"message": {Type: "string"},
+		"data": {
+			Type: "array",
+			Items: &openapiSchemaObject{
+				Type: "object",
+				Properties: map[string]*openapiSchemaObject{
+					"@type": {Type: "string"},
+				},
+				Required: []string{"@type"},
+			},
+		},
+	},
+	Required: []string{"code", "message"},
+}
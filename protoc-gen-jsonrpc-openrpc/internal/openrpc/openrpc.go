@@ -0,0 +1,162 @@
+package openrpc
+
+import (
+	"encoding/json"
+	"sort"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"google.golang.org/genproto/googleapis/api/visibility"
+
+	"github.com/yxlimo/go-jsonrpc-gateway/internal/jsonschema"
+	"github.com/yxlimo/go-jsonrpc-gateway/internal/schemagen"
+	"github.com/yxlimo/go-jsonrpc-gateway/protoc-gen-go-jsonrpc-proxy/options"
+)
+
+var _ pgs.Module = &Openrpc{}
+
+// Openrpc is a protoc-gen-star module which generates an OpenRPC document for
+// every proto file that defines services.
+type Openrpc struct {
+	base *pgs.ModuleBase
+	ctx  pgsgo.Context
+
+	// schemas generates the component schemas of the document being
+	// generated
+	schemas *schemagen.Generator
+}
+
+func New() *Openrpc {
+	return &Openrpc{
+		base: &pgs.ModuleBase{},
+	}
+}
+
+func (o *Openrpc) Name() string {
+	return "jsonrpc-openrpc"
+}
+
+func (o *Openrpc) InitContext(c pgs.BuildContext) {
+	o.base.InitContext(c)
+	o.ctx = pgsgo.InitContext(c.Parameters())
+	o.schemas = schemagen.New(o.base, jsonschema.Draft07)
+}
+
+func (o *Openrpc) Parameters() pgs.Parameters {
+	return o.base.Parameters()
+}
+
+func (o *Openrpc) Execute(targets map[string]pgs.File, packages map[string]pgs.Package) []pgs.Artifact {
+	// generate in a stable order, as the openapi plugin does
+	names := make([]string, 0, len(targets))
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		file := targets[name]
+		if len(file.Services()) == 0 {
+			o.base.Debugf("skip file without services: %s", file.Name())
+			continue
+		}
+		o.generate(file)
+	}
+	return o.base.Artifacts()
+}
+
+func (o *Openrpc) generate(file pgs.File) {
+	o.schemas.Reset(nil)
+	object := openrpcObject{
+		Version: "1.2.6",
+		Info: openrpcInfoObject{
+			Title:   file.Name().String(),
+			Version: "0.0.1",
+		},
+		Methods: []*openrpcMethodObject{},
+	}
+	for _, service := range file.Services() {
+		if !o.schemas.IsVisible(service, visibility.E_ApiVisibility) {
+			continue
+		}
+		o.base.Debugf("gen service: %s", service.FullyQualifiedName())
+		for _, method := range service.Methods() {
+			if method.ClientStreaming() || method.ServerStreaming() || !o.schemas.IsVisible(method, visibility.E_MethodVisibility) {
+				continue
+			}
+			object.Methods = append(object.Methods, o.genMethod(method))
+		}
+	}
+	object.Components.Schemas = o.schemas.Schemas()
+	object.Components.Errors = errorComponents
+	content, err := json.Marshal(&object)
+	o.base.CheckErr(err, "marshal openrpc document of ", file.Name())
+	name := o.ctx.OutputPath(file).SetExt(".openrpc.json")
+	o.base.AddGeneratorFile(name.String(), string(content))
+}
+
+func (o *Openrpc) genMethod(m pgs.Method) *openrpcMethodObject {
+	summary, description := schemagen.SplitComments(schemagen.Comments(m), false)
+	method := &openrpcMethodObject{
+		Name:           m.Name().UpperCamelCase().String(),
+		Summary:        summary,
+		Description:    description,
+		Tags:           []*openrpcTagObject{{Name: m.Service().Name().String()}},
+		ParamStructure: "by-name",
+		Params:         o.genParams(m.Input()),
+		Result: &openrpcContentDescriptor{
+			Name:   m.Output().Name().String(),
+			Schema: o.schemas.MessageSchema(m.Output()),
+		},
+		Errors: o.genErrors(m),
+		Deprecated: m.Descriptor().GetOptions().GetDeprecated() ||
+			m.Service().Descriptor().GetOptions().GetDeprecated(),
+	}
+	return method
+}
+
+// genErrors returns the errors any method can return followed by the errors
+// listed in the jsonrpc_method option of m.
+func (o *Openrpc) genErrors(m pgs.Method) []*openrpcErrorObject {
+	var opts *options.JSONRPCMethod
+	_, err := m.Extension(options.E_JsonrpcMethod, &opts)
+	o.base.CheckErr(err, "read jsonrpc_method option of ", m.FullyQualifiedName())
+	errors := append([]*openrpcErrorObject{}, methodErrors...)
+	for _, e := range opts.GetErrors() {
+		message := e.GetReason()
+		if message == "" {
+			message = schemagen.ErrorCodeName(e.GetCode())
+		}
+		errors = append(errors, &openrpcErrorObject{
+			Code:    int(e.GetCode()),
+			Message: message,
+			Data:    e.GetDescription(),
+		})
+	}
+	return errors
+}
+
+// genParams describes every field of the request message as a named param,
+// since the gateway decodes the params object into the request message. Output
+// only fields are left out, as they are of the request schemas of OpenAPI
+// documents.
+func (o *Openrpc) genParams(msg pgs.Message) []*openrpcContentDescriptor {
+	params := []*openrpcContentDescriptor{}
+	if o.schemas.IsWellKnownType(msg) {
+		return params
+	}
+	for _, property := range o.schemas.Properties(msg) {
+		if property.Schema.ReadOnly {
+			continue
+		}
+		summary, description := schemagen.SplitComments(schemagen.Comments(property.Field), true)
+		params = append(params, &openrpcContentDescriptor{
+			Name:        property.Name,
+			Summary:     summary,
+			Description: description,
+			Required:    property.Required,
+			Schema:      property.Schema,
+			Deprecated:  property.Field.Descriptor().GetOptions().GetDeprecated(),
+		})
+	}
+	return params
+}
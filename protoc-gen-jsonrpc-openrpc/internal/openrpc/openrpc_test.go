@@ -0,0 +1,259 @@
+package openrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	testproto "github.com/yxlimo/go-jsonrpc-gateway/test/proto"
+	everything "github.com/yxlimo/go-jsonrpc-gateway/test/proto/everything"
+	recursive "github.com/yxlimo/go-jsonrpc-gateway/test/proto/recursive-reference"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+const everythingDocument = "test/proto/everything/a_bit_of_everything.pb.openrpc.json"
+
+func TestGenerateGolden(t *testing.T) {
+	file := descriptorSetFile(t, "../../../test/proto/everything/a_bit_of_everything.binpb", "test/proto/everything/a_bit_of_everything.proto")
+	for _, spec := range []struct {
+		params string
+		golden string
+	}{
+		{
+			params: "paths=source_relative",
+			golden: "a_bit_of_everything.openrpc.json",
+		},
+		{
+			params: "paths=source_relative,json_names_for_fields=false,enums_as_ints=true",
+			golden: "a_bit_of_everything_proto_names_enums_as_ints.openrpc.json",
+		},
+	} {
+		t.Run(spec.golden, func(t *testing.T) {
+			var got bytes.Buffer
+			if err := json.Indent(&got, generate(t, []protoreflect.FileDescriptor{file}, spec.params)[everythingDocument], "", "  "); err != nil {
+				t.Fatal(err)
+			}
+			got.WriteByte('\n')
+			golden := filepath.Join("testdata", spec.golden)
+			if *update {
+				if err := ioutil.WriteFile(golden, got.Bytes(), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got.Bytes(), want) {
+				t.Errorf("generated %s differs from %s; run go test -update and review the diff", file.Path(), golden)
+			}
+		})
+	}
+}
+
+// TestGenerateSchemas checks that messages are described as in OpenAPI
+// documents, in JSON Schema draft 7.
+func TestGenerateSchemas(t *testing.T) {
+	files := []protoreflect.FileDescriptor{everything.File_test_proto_everything_a_bit_of_everything_proto}
+	doc := document(t, files, "paths=source_relative,proto3_optional_nullable=true", everythingDocument)
+	schemas := doc.Components.Schemas
+	properties := schemas["everything.ABitOfEverything"].Properties
+
+	float := properties["floatValue"]
+	if len(float.OneOf) != 2 || !reflect.DeepEqual(float.OneOf[1].Enum, []interface{}{"NaN", "Infinity", "-Infinity"}) {
+		t.Errorf("floatValue = %+v; want a number or a non finite string", float)
+	}
+	for _, name := range []string{"optionalStringValue", "wrappedStringValue", "wrappedInt64Value"} {
+		alternatives := properties[name].AnyOf
+		if len(alternatives) != 2 || alternatives[1].Type != "null" {
+			t.Errorf("%s = %+v; want it or null", name, properties[name])
+		}
+	}
+	if wrapped := properties["wrappedInt64Value"].AnyOf[0]; wrapped.Type != "string" || wrapped.Format != "int64" {
+		t.Errorf("wrappedInt64Value = %+v; want an int64 string", wrapped)
+	}
+	if values := properties["structValue"].AdditionalProperties; values == nil {
+		t.Error("structValue has no additionalProperties")
+	}
+	if properties["nullValue"].Type != "null" {
+		t.Errorf("nullValue = %+v; want null", properties["nullValue"])
+	}
+	if required := schemas["everything.ABitOfEverything"].Required; !reflect.DeepEqual(required, []string{"requiredStringViaFieldBehaviorAnnotation"}) {
+		t.Errorf("ABitOfEverything requires %v", required)
+	}
+	if discriminator := schemas["everything.ABitOfEverything"].Discriminator; discriminator == nil || discriminator.OneOf != "oneof_value" {
+		t.Errorf("ABitOfEverything discriminator = %+v; want oneof_value", discriminator)
+	}
+	if outlier := schemas["everything.ValidatedMessage"].Properties["outlier"]; len(outlier.AnyOf) != 2 || outlier.AnyOf[0].ExclusiveMinimum != float64(100) {
+		t.Errorf("outlier = %+v; want draft 7 exclusive bounds", outlier)
+	}
+
+	create := method(t, doc, "Create")
+	params := make(map[string]*openrpcContentDescriptor, len(create.Params))
+	for _, param := range create.Params {
+		params[param.Name] = param
+	}
+	if _, ok := params["outputOnlyStringViaFieldBehaviorAnnotation"]; ok {
+		t.Error("Create takes the output only field as param")
+	}
+	if param := params["requiredStringViaFieldBehaviorAnnotation"]; param == nil || !param.Required {
+		t.Errorf("Create param requiredStringViaFieldBehaviorAnnotation = %+v; want required", param)
+	}
+}
+
+func TestGenerateParameters(t *testing.T) {
+	files := []protoreflect.FileDescriptor{everything.File_test_proto_everything_a_bit_of_everything_proto}
+	doc := document(t, files, "paths=source_relative,json_names_for_fields=false,enums_as_ints=true", everythingDocument)
+	properties := doc.Components.Schemas["everything.ABitOfEverything"].Properties
+	if _, ok := properties["int32_value"]; !ok {
+		t.Error("ABitOfEverything has no property int32_value")
+	}
+	if optional := properties["optional_string_value"]; optional == nil || optional.Type != "string" {
+		t.Errorf("optional_string_value = %+v; want a string", optional)
+	}
+	enum := doc.Components.Schemas["everything.NumericEnum"]
+	if enum.Type != "integer" || !reflect.DeepEqual(enum.Enum, []interface{}{float64(0), float64(1)}) {
+		t.Errorf("NumericEnum = %+v; want its numbers", enum)
+	}
+}
+
+func TestGenerateComments(t *testing.T) {
+	file := descriptorSetFile(t, "../../../test/proto/everything/a_bit_of_everything.binpb", "test/proto/everything/a_bit_of_everything.proto")
+	doc := document(t, []protoreflect.FileDescriptor{file}, "paths=source_relative", everythingDocument)
+	book := doc.Components.Schemas["everything.Book"]
+	if book.Title != "" || book.Description == "" {
+		t.Errorf("Book titled %q, described %q; want a description only", book.Title, book.Description)
+	}
+	create := method(t, doc, "CreateBook")
+	for _, param := range create.Params {
+		if param.Name == "parent" && param.Summary != "" {
+			t.Errorf("param parent summarized %q; want its sentence in the description", param.Summary)
+		}
+	}
+}
+
+func TestGenerateStableOrder(t *testing.T) {
+	files := []protoreflect.FileDescriptor{
+		recursive.File_test_proto_recursive_reference_tree_service_proto,
+		testproto.File_test_proto_hello_proto,
+		recursive.File_test_proto_recursive_reference_recursive_service_proto,
+	}
+	res := render(t, files, "paths=source_relative")
+	var names []string
+	for _, file := range res.GetFile() {
+		names = append(names, file.GetName())
+	}
+	want := []string{
+		"test/proto/hello.pb.openrpc.json",
+		"test/proto/recursive-reference/recursive_service.pb.openrpc.json",
+		"test/proto/recursive-reference/tree_service.pb.openrpc.json",
+	}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("generated %v; want %v", names, want)
+	}
+}
+
+// method returns the method named name of doc.
+func method(t *testing.T, doc *openrpcObject, name string) *openrpcMethodObject {
+	for _, m := range doc.Methods {
+		if m.Name == name {
+			return m
+		}
+	}
+	t.Fatalf("document has no method %s", name)
+	return nil
+}
+
+// document runs the module on files and decodes the document it generates to
+// name.
+func document(t *testing.T, files []protoreflect.FileDescriptor, params, name string) *openrpcObject {
+	var doc openrpcObject
+	if err := json.Unmarshal(generate(t, files, params)[name], &doc); err != nil {
+		t.Fatal(err)
+	}
+	return &doc
+}
+
+// generate runs the module on files and returns the documents it generates by
+// name.
+func generate(t *testing.T, files []protoreflect.FileDescriptor, params string) map[string][]byte {
+	documents := make(map[string][]byte)
+	for _, file := range render(t, files, params).GetFile() {
+		documents[file.GetName()] = []byte(file.GetContent())
+	}
+	return documents
+}
+
+// render runs the module on files as protoc would and returns its response.
+func render(t *testing.T, files []protoreflect.FileDescriptor, params string) *pluginpb.CodeGeneratorResponse {
+	req := &pluginpb.CodeGeneratorRequest{
+		Parameter: proto.String(params),
+	}
+	seen := make(map[string]bool)
+	for _, file := range files {
+		req.FileToGenerate = append(req.FileToGenerate, file.Path())
+		req.ProtoFile = withImports(file, req.ProtoFile, seen)
+	}
+	in, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	pgs.Init(pgs.ProtocInput(bytes.NewReader(in)), pgs.ProtocOutput(&out)).
+		RegisterModule(New()).Render()
+
+	var res pluginpb.CodeGeneratorResponse
+	if err := proto.Unmarshal(out.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	return &res
+}
+
+// withImports appends file after its transitive imports to files, in the
+// topological order protoc uses.
+func withImports(file protoreflect.FileDescriptor, files []*descriptorpb.FileDescriptorProto, seen map[string]bool) []*descriptorpb.FileDescriptorProto {
+	if seen[file.Path()] {
+		return files
+	}
+	seen[file.Path()] = true
+	imports := file.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		files = withImports(imports.Get(i).FileDescriptor, files, seen)
+	}
+	return append(files, protodesc.ToFileDescriptorProto(file))
+}
+
+// descriptorSetFile returns the file path of the descriptor set name. Unlike
+// the descriptors registered by protoc-gen-go, the sets keep the source info,
+// so the documents generated from them have comments.
+func descriptorSetFile(t *testing.T, name, path string) protoreflect.FileDescriptor {
+	content, err := ioutil.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(content, &set); err != nil {
+		t.Fatal(err)
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := files.FindFileByPath(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
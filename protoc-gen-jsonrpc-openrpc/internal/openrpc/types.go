@@ -0,0 +1,58 @@
+package openrpc
+
+import "github.com/yxlimo/go-jsonrpc-gateway/internal/jsonschema"
+
+// https://spec.open-rpc.org/#openrpc-object
+type openrpcObject struct {
+	Version    string                  `json:"openrpc"`
+	Info       openrpcInfoObject       `json:"info"`
+	Methods    []*openrpcMethodObject  `json:"methods"`
+	Components openrpcComponentsObject `json:"components"`
+}
+
+type openrpcInfoObject struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type openrpcMethodObject struct {
+	Name           string                      `json:"name"`
+	Summary        string                      `json:"summary,omitempty"`
+	Description    string                      `json:"description,omitempty"`
+	Tags           []*openrpcTagObject         `json:"tags,omitempty"`
+	ParamStructure string                      `json:"paramStructure,omitempty"`
+	Params         []*openrpcContentDescriptor `json:"params"`
+	Result         *openrpcContentDescriptor   `json:"result"`
+	Errors         []*openrpcErrorObject       `json:"errors,omitempty"`
+	Deprecated     bool                        `json:"deprecated,omitempty"`
+}
+
+type openrpcTagObject struct {
+	Name string `json:"name"`
+}
+
+type openrpcContentDescriptor struct {
+	Name        string        `json:"name"`
+	Summary     string        `json:"summary,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Required    bool          `json:"required,omitempty"`
+	Schema      *schemaObject `json:"schema"`
+	Deprecated  bool          `json:"deprecated,omitempty"`
+}
+
+// openrpcErrorObject is either an error definition or a reference to one in components.
+type openrpcErrorObject struct {
+	Ref     string `json:"$ref,omitempty"`
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+	Data    string `json:"data,omitempty"`
+}
+
+type openrpcComponentsObject struct {
+	Schemas map[string]*schemaObject       `json:"schemas"`
+	Errors  map[string]*openrpcErrorObject `json:"errors"`
+}
+
+// schemaObject is a JSON Schema draft 7, as used by OpenRPC.
+type schemaObject = jsonschema.Schema
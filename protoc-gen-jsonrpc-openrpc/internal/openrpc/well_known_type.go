@@ -0,0 +1,49 @@
+package openrpc
+
+import (
+	"sort"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/yxlimo/go-jsonrpc-gateway/jsonrpc"
+)
+
+// invalidParamsErrorName is the components key of the error returned for
+// requests failing validation.
+const invalidParamsErrorName = "InvalidParams"
+
+// errorComponents defines the errors returned by the gateway. Error codes are
+// gRPC codes, except for DeadlineExceeded which has its own JSON-RPC code, and
+// InvalidArgument errors of requests failing validation, reported as invalid
+// params.
+var errorComponents = func() map[string]*openrpcErrorObject {
+	errors := make(map[string]*openrpcErrorObject)
+	for code := codes.Canceled; code <= codes.Unauthenticated; code++ {
+		errors[code.String()] = &openrpcErrorObject{
+			Code:    int(code),
+			Message: code.String(),
+		}
+	}
+	errors[codes.DeadlineExceeded.String()].Code = jsonrpc.DeadlineExceededErrorCode
+	errors[invalidParamsErrorName] = &openrpcErrorObject{
+		Code:    jsonrpc.InvalidParamsErrorCode,
+		Message: invalidParamsErrorName,
+		Data:    "the request failed validation; data holds a google.rpc.BadRequest listing the field violations",
+	}
+	return errors
+}()
+
+// methodErrors references the errors any method can return, regardless of
+// what the backend does.
+var methodErrors = func() []*openrpcErrorObject {
+	names := []string{invalidParamsErrorName}
+	for _, code := range []codes.Code{codes.InvalidArgument, codes.DeadlineExceeded, codes.Internal, codes.Unavailable} {
+		names = append(names, code.String())
+	}
+	sort.Strings(names)
+	errors := make([]*openrpcErrorObject, 0, len(names))
+	for _, name := range names {
+		errors = append(errors, &openrpcErrorObject{Ref: "#/components/errors/" + name})
+	}
+	return errors
+}()
@@ -0,0 +1,12 @@
+package main
+
+import (
+	pgs "github.com/lyft/protoc-gen-star"
+
+	"github.com/yxlimo/go-jsonrpc-gateway/protoc-gen-jsonrpc-openrpc/internal/openrpc"
+)
+
+func main() {
+	pgs.Init(pgs.DebugEnv("DEBUG")).
+		RegisterModule(openrpc.New()).Render()
+}
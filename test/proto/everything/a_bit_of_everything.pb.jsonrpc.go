@@ -12,6 +12,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/yxlimo/go-jsonrpc-gateway/jsonrpc"
@@ -26,6 +27,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // Suppress "imported and not used" errors
@@ -37,252 +39,340 @@ var _ = metadata.Join
 var _ = json.Marshal
 var _ = jsonrpc.NewHTTPServerConn
 
-func request_ABitOfEverythingService_Create_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_Create_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq ABitOfEverything
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.Create(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_CreateBody_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_CreateBody_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq ABitOfEverything
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.CreateBody(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_CreateBook_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_CreateBook_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq CreateBookRequest
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.CreateBook(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_UpdateBook_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_UpdateBook_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq UpdateBookRequest
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.UpdateBook(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_Lookup_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_Lookup_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq sub2.IdMessage
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.Lookup(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_Update_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_Update_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq ABitOfEverything
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.Update(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_UpdateV2_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_UpdateV2_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq UpdateV2Request
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.UpdateV2(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_Delete_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_Delete_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq sub2.IdMessage
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.Delete(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_GetQuery_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_GetQuery_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq ABitOfEverything
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.GetQuery(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_GetRepeatedQuery_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_GetRepeatedQuery_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq ABitOfEverythingRepeated
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.GetRepeatedQuery(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_Echo_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_Echo_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq sub.StringMessage
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.Echo(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_DeepPathEcho_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_DeepPathEcho_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq ABitOfEverything
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.DeepPathEcho(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_NoBindings_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_NoBindings_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq durationpb.Duration
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.NoBindings(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_Timeout_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_Timeout_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq emptypb.Empty
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.Timeout(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_ErrorWithDetails_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_ErrorWithDetails_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq emptypb.Empty
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.ErrorWithDetails(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_GetMessageWithBody_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_GetMessageWithBody_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq MessageWithBody
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.GetMessageWithBody(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_PostWithEmptyBody_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_PostWithEmptyBody_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq Body
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.PostWithEmptyBody(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_CheckGetQueryParams_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_CheckGetQueryParams_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq ABitOfEverything
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.CheckGetQueryParams(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_CheckNestedEnumGetQueryParams_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_CheckNestedEnumGetQueryParams_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq ABitOfEverything
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.CheckNestedEnumGetQueryParams(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_CheckPostQueryParams_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_CheckPostQueryParams_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq ABitOfEverything
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.CheckPostQueryParams(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_OverwriteResponseContentType_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_OverwriteResponseContentType_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq emptypb.Empty
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.OverwriteResponseContentType(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_CheckExternalPathEnum_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_CheckExternalPathEnum_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq pathenum.MessageWithPathEnum
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.CheckExternalPathEnum(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_ABitOfEverythingService_CheckExternalNestedPathEnum_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_CheckExternalNestedPathEnum_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq pathenum.MessageWithNestedPathEnum
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.CheckExternalNestedPathEnum(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_CamelCaseServiceName_Empty_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client CamelCaseServiceNameClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_ABitOfEverythingService_CheckValidation_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client ABitOfEverythingServiceClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ValidatedMessage
+	var metadata runtime.ServerMetadata
+	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
+	msg, err := client.CheckValidation(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func request_CamelCaseServiceName_Empty_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client CamelCaseServiceNameClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq emptypb.Empty
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.Empty(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_AnotherServiceWithNoBindings_NoBindings_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client AnotherServiceWithNoBindingsClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_AnotherServiceWithNoBindings_NoBindings_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client AnotherServiceWithNoBindingsClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq emptypb.Empty
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.NoBindings(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
@@ -325,7 +415,7 @@ func RegisterABitOfEverythingServiceJSONRPCHandler(ctx context.Context, mux *jso
 // "ABitOfEverythingServiceClient" to call the correct interceptors.
 func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mux *jsonrpc.ServeMux, client ABitOfEverythingServiceClient) error {
 
-	mux.Register("Create", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("Create", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -333,20 +423,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_Create_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_Create_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/Create"))
 
-	mux.Register("CreateBody", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("CreateBody", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -354,20 +436,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_CreateBody_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_CreateBody_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/CreateBody"))
 
-	})
-
-	mux.Register("CreateBook", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("CreateBook", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -375,20 +449,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_CreateBook_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_CreateBook_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/CreateBook"))
 
-	})
-
-	mux.Register("UpdateBook", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("UpdateBook", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -396,20 +462,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_UpdateBook_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_UpdateBook_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/UpdateBook"))
 
-	})
-
-	mux.Register("Lookup", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("Lookup", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -417,20 +475,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_Lookup_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_Lookup_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/Lookup"))
 
-	})
-
-	mux.Register("Update", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("Update", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -438,20 +488,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_Update_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_Update_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/Update"))
 
-	})
-
-	mux.Register("UpdateV2", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("UpdateV2", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -459,20 +501,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_UpdateV2_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_UpdateV2_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/UpdateV2"))
 
-	})
-
-	mux.Register("Delete", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("Delete", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -480,20 +514,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_Delete_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_Delete_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/Delete"))
 
-	mux.Register("GetQuery", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("GetQuery", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -501,20 +527,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_GetQuery_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_GetQuery_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/GetQuery"))
 
-	})
-
-	mux.Register("GetRepeatedQuery", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("GetRepeatedQuery", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -522,20 +540,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_GetRepeatedQuery_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_GetRepeatedQuery_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/GetRepeatedQuery"))
 
-	mux.Register("Echo", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("Echo", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -543,20 +553,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_Echo_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_Echo_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/Echo"))
 
-	mux.Register("DeepPathEcho", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("DeepPathEcho", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -564,20 +566,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_DeepPathEcho_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_DeepPathEcho_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/DeepPathEcho"))
 
-	mux.Register("NoBindings", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("NoBindings", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -585,20 +579,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_NoBindings_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_NoBindings_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/NoBindings"))
 
-	mux.Register("Timeout", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("Timeout", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -606,20 +592,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_Timeout_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_Timeout_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/Timeout"), jsonrpc.WithDefaultTimeout(5*time.Second))
 
-	mux.Register("ErrorWithDetails", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("ErrorWithDetails", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -627,20 +605,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_ErrorWithDetails_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_ErrorWithDetails_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/ErrorWithDetails"))
 
-	mux.Register("GetMessageWithBody", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("GetMessageWithBody", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -648,20 +618,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_GetMessageWithBody_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_GetMessageWithBody_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/GetMessageWithBody"))
 
-	mux.Register("PostWithEmptyBody", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("PostWithEmptyBody", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -669,20 +631,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_PostWithEmptyBody_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_PostWithEmptyBody_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/PostWithEmptyBody"))
 
-	})
-
-	mux.Register("CheckGetQueryParams", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("CheckGetQueryParams", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -690,20 +644,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_CheckGetQueryParams_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_CheckGetQueryParams_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/CheckGetQueryParams"))
 
-	mux.Register("CheckNestedEnumGetQueryParams", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("CheckNestedEnumGetQueryParams", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -711,20 +657,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_CheckNestedEnumGetQueryParams_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_CheckNestedEnumGetQueryParams_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/CheckNestedEnumGetQueryParams"))
 
-	mux.Register("CheckPostQueryParams", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("CheckPostQueryParams", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -732,20 +670,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_CheckPostQueryParams_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_CheckPostQueryParams_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/CheckPostQueryParams"))
 
-	mux.Register("OverwriteResponseContentType", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("OverwriteResponseContentType", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -753,20 +683,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_OverwriteResponseContentType_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_OverwriteResponseContentType_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/OverwriteResponseContentType"))
 
-	mux.Register("CheckExternalPathEnum", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("CheckExternalPathEnum", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -774,20 +696,12 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_CheckExternalPathEnum_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_CheckExternalPathEnum_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/CheckExternalPathEnum"))
 
-	mux.Register("CheckExternalNestedPathEnum", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("CheckExternalNestedPathEnum", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -795,18 +709,23 @@ func RegisterABitOfEverythingServiceJSONRPCHandlerClient(ctx context.Context, mu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_ABitOfEverythingService_CheckExternalNestedPathEnum_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_ABitOfEverythingService_CheckExternalNestedPathEnum_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/CheckExternalNestedPathEnum"))
+
+	mux.Register("CheckValidation", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var err error
+		ctx, err = runtime.AnnotateContext(ctx, mux.RuntimeMux(), req, "/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/CheckValidation")
 		if err != nil {
 			return nil, ctx, err
 		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		resp, md, err := request_ABitOfEverythingService_CheckValidation_jsonrpc(ctx, mux, marshaller, client, rawBody)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/CheckValidation"))
 
 	return nil
 }
@@ -849,7 +768,7 @@ func RegisterCamelCaseServiceNameJSONRPCHandler(ctx context.Context, mux *jsonrp
 // "CamelCaseServiceNameClient" to call the correct interceptors.
 func RegisterCamelCaseServiceNameJSONRPCHandlerClient(ctx context.Context, mux *jsonrpc.ServeMux, client CamelCaseServiceNameClient) error {
 
-	mux.Register("Empty", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("Empty", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -857,18 +776,10 @@ func RegisterCamelCaseServiceNameJSONRPCHandlerClient(ctx context.Context, mux *
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_CamelCaseServiceName_Empty_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_CamelCaseServiceName_Empty_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.CamelCaseServiceName/Empty"))
 
 	return nil
 }
@@ -911,7 +822,7 @@ func RegisterAnotherServiceWithNoBindingsJSONRPCHandler(ctx context.Context, mux
 // "AnotherServiceWithNoBindingsClient" to call the correct interceptors.
 func RegisterAnotherServiceWithNoBindingsJSONRPCHandlerClient(ctx context.Context, mux *jsonrpc.ServeMux, client AnotherServiceWithNoBindingsClient) error {
 
-	mux.Register("NoBindings", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("NoBindings", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -919,18 +830,258 @@ func RegisterAnotherServiceWithNoBindingsJSONRPCHandlerClient(ctx context.Contex
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_AnotherServiceWithNoBindings_NoBindings_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_AnotherServiceWithNoBindings_NoBindings_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.everything.AnotherServiceWithNoBindings/NoBindings"))
 
 	return nil
 }
+
+// ABitOfEverythingServiceJSONRPCClient calls the methods of service ABitOfEverythingService registered on a jsonrpc.ServeMux.
+// Methods hidden by visibility restrictions are left out, so it only implements ABitOfEverythingServiceClient
+// without them. Streaming methods fail with codes.Unimplemented.
+type ABitOfEverythingServiceJSONRPCClient struct {
+	cc *jsonrpc.Client
+}
+
+// NewABitOfEverythingServiceJSONRPCClient returns a ABitOfEverythingServiceJSONRPCClient sending calls through "cc".
+func NewABitOfEverythingServiceJSONRPCClient(cc *jsonrpc.Client) *ABitOfEverythingServiceJSONRPCClient {
+	return &ABitOfEverythingServiceJSONRPCClient{cc: cc}
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) Create(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	out := new(ABitOfEverything)
+	if err := c.cc.Call(ctx, "Create", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) CreateBody(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	out := new(ABitOfEverything)
+	if err := c.cc.Call(ctx, "CreateBody", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) CreateBook(ctx context.Context, in *CreateBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	if err := c.cc.Call(ctx, "CreateBook", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	if err := c.cc.Call(ctx, "UpdateBook", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) Lookup(ctx context.Context, in *sub2.IdMessage, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	out := new(ABitOfEverything)
+	if err := c.cc.Call(ctx, "Lookup", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) Update(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	if err := c.cc.Call(ctx, "Update", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) UpdateV2(ctx context.Context, in *UpdateV2Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	if err := c.cc.Call(ctx, "UpdateV2", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) Delete(ctx context.Context, in *sub2.IdMessage, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	if err := c.cc.Call(ctx, "Delete", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) GetQuery(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	if err := c.cc.Call(ctx, "GetQuery", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) GetRepeatedQuery(ctx context.Context, in *ABitOfEverythingRepeated, opts ...grpc.CallOption) (*ABitOfEverythingRepeated, error) {
+	out := new(ABitOfEverythingRepeated)
+	if err := c.cc.Call(ctx, "GetRepeatedQuery", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) Echo(ctx context.Context, in *sub.StringMessage, opts ...grpc.CallOption) (*sub.StringMessage, error) {
+	out := new(sub.StringMessage)
+	if err := c.cc.Call(ctx, "Echo", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) DeepPathEcho(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	out := new(ABitOfEverything)
+	if err := c.cc.Call(ctx, "DeepPathEcho", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) NoBindings(ctx context.Context, in *durationpb.Duration, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	if err := c.cc.Call(ctx, "NoBindings", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) Timeout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	if err := c.cc.Call(ctx, "Timeout", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) ErrorWithDetails(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	if err := c.cc.Call(ctx, "ErrorWithDetails", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) GetMessageWithBody(ctx context.Context, in *MessageWithBody, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	if err := c.cc.Call(ctx, "GetMessageWithBody", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) PostWithEmptyBody(ctx context.Context, in *Body, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	if err := c.cc.Call(ctx, "PostWithEmptyBody", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) CheckGetQueryParams(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	out := new(ABitOfEverything)
+	if err := c.cc.Call(ctx, "CheckGetQueryParams", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) CheckNestedEnumGetQueryParams(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	out := new(ABitOfEverything)
+	if err := c.cc.Call(ctx, "CheckNestedEnumGetQueryParams", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) CheckPostQueryParams(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	out := new(ABitOfEverything)
+	if err := c.cc.Call(ctx, "CheckPostQueryParams", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) OverwriteResponseContentType(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	out := new(wrapperspb.StringValue)
+	if err := c.cc.Call(ctx, "OverwriteResponseContentType", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) CheckExternalPathEnum(ctx context.Context, in *pathenum.MessageWithPathEnum, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	if err := c.cc.Call(ctx, "CheckExternalPathEnum", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) CheckExternalNestedPathEnum(ctx context.Context, in *pathenum.MessageWithNestedPathEnum, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	if err := c.cc.Call(ctx, "CheckExternalNestedPathEnum", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ABitOfEverythingServiceJSONRPCClient) CheckValidation(ctx context.Context, in *ValidatedMessage, opts ...grpc.CallOption) (*ValidatedMessage, error) {
+	out := new(ValidatedMessage)
+	if err := c.cc.Call(ctx, "CheckValidation", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CamelCaseServiceNameJSONRPCClient is a CamelCaseServiceNameClient calling the methods of service CamelCaseServiceName
+// registered on a jsonrpc.ServeMux, so that callers can switch between gRPC and JSON-RPC transparently.
+// Streaming methods fail with codes.Unimplemented.
+type CamelCaseServiceNameJSONRPCClient struct {
+	cc *jsonrpc.Client
+}
+
+var _ CamelCaseServiceNameClient = (*CamelCaseServiceNameJSONRPCClient)(nil)
+
+// NewCamelCaseServiceNameJSONRPCClient returns a CamelCaseServiceNameJSONRPCClient sending calls through "cc".
+func NewCamelCaseServiceNameJSONRPCClient(cc *jsonrpc.Client) *CamelCaseServiceNameJSONRPCClient {
+	return &CamelCaseServiceNameJSONRPCClient{cc: cc}
+}
+
+func (c *CamelCaseServiceNameJSONRPCClient) Empty(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	if err := c.cc.Call(ctx, "Empty", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnotherServiceWithNoBindingsJSONRPCClient is a AnotherServiceWithNoBindingsClient calling the methods of service AnotherServiceWithNoBindings
+// registered on a jsonrpc.ServeMux, so that callers can switch between gRPC and JSON-RPC transparently.
+// Streaming methods fail with codes.Unimplemented.
+type AnotherServiceWithNoBindingsJSONRPCClient struct {
+	cc *jsonrpc.Client
+}
+
+var _ AnotherServiceWithNoBindingsClient = (*AnotherServiceWithNoBindingsJSONRPCClient)(nil)
+
+// NewAnotherServiceWithNoBindingsJSONRPCClient returns a AnotherServiceWithNoBindingsJSONRPCClient sending calls through "cc".
+func NewAnotherServiceWithNoBindingsJSONRPCClient(cc *jsonrpc.Client) *AnotherServiceWithNoBindingsJSONRPCClient {
+	return &AnotherServiceWithNoBindingsJSONRPCClient{cc: cc}
+}
+
+func (c *AnotherServiceWithNoBindingsJSONRPCClient) NoBindings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	if err := c.cc.Call(ctx, "NoBindings", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
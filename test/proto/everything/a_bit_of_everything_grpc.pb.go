@@ -34,6 +34,7 @@ type ABitOfEverythingServiceClient interface {
 	UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*Book, error)
 	Lookup(ctx context.Context, in *sub2.IdMessage, opts ...grpc.CallOption) (*ABitOfEverything, error)
 	Update(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Deprecated: Do not use.
 	UpdateV2(ctx context.Context, in *UpdateV2Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Delete(ctx context.Context, in *sub2.IdMessage, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetQuery(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -58,6 +59,8 @@ type ABitOfEverythingServiceClient interface {
 	OverwriteResponseContentType(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	CheckExternalPathEnum(ctx context.Context, in *pathenum.MessageWithPathEnum, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CheckExternalNestedPathEnum(ctx context.Context, in *pathenum.MessageWithNestedPathEnum, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CheckValidation(ctx context.Context, in *ValidatedMessage, opts ...grpc.CallOption) (*ValidatedMessage, error)
+	InternalOnly(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type aBitOfEverythingServiceClient struct {
@@ -122,6 +125,7 @@ func (c *aBitOfEverythingServiceClient) Update(ctx context.Context, in *ABitOfEv
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *aBitOfEverythingServiceClient) UpdateV2(ctx context.Context, in *UpdateV2Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/UpdateV2", in, out, opts...)
@@ -275,6 +279,24 @@ func (c *aBitOfEverythingServiceClient) CheckExternalNestedPathEnum(ctx context.
 	return out, nil
 }
 
+func (c *aBitOfEverythingServiceClient) CheckValidation(ctx context.Context, in *ValidatedMessage, opts ...grpc.CallOption) (*ValidatedMessage, error) {
+	out := new(ValidatedMessage)
+	err := c.cc.Invoke(ctx, "/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/CheckValidation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aBitOfEverythingServiceClient) InternalOnly(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/InternalOnly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ABitOfEverythingServiceServer is the server API for ABitOfEverythingService service.
 // All implementations should embed UnimplementedABitOfEverythingServiceServer
 // for forward compatibility
@@ -289,6 +311,7 @@ type ABitOfEverythingServiceServer interface {
 	UpdateBook(context.Context, *UpdateBookRequest) (*Book, error)
 	Lookup(context.Context, *sub2.IdMessage) (*ABitOfEverything, error)
 	Update(context.Context, *ABitOfEverything) (*emptypb.Empty, error)
+	// Deprecated: Do not use.
 	UpdateV2(context.Context, *UpdateV2Request) (*emptypb.Empty, error)
 	Delete(context.Context, *sub2.IdMessage) (*emptypb.Empty, error)
 	GetQuery(context.Context, *ABitOfEverything) (*emptypb.Empty, error)
@@ -313,6 +336,8 @@ type ABitOfEverythingServiceServer interface {
 	OverwriteResponseContentType(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
 	CheckExternalPathEnum(context.Context, *pathenum.MessageWithPathEnum) (*emptypb.Empty, error)
 	CheckExternalNestedPathEnum(context.Context, *pathenum.MessageWithNestedPathEnum) (*emptypb.Empty, error)
+	CheckValidation(context.Context, *ValidatedMessage) (*ValidatedMessage, error)
+	InternalOnly(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 }
 
 // UnimplementedABitOfEverythingServiceServer should be embedded to have forward compatible implementations.
@@ -388,6 +413,12 @@ func (UnimplementedABitOfEverythingServiceServer) CheckExternalPathEnum(context.
 func (UnimplementedABitOfEverythingServiceServer) CheckExternalNestedPathEnum(context.Context, *pathenum.MessageWithNestedPathEnum) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckExternalNestedPathEnum not implemented")
 }
+func (UnimplementedABitOfEverythingServiceServer) CheckValidation(context.Context, *ValidatedMessage) (*ValidatedMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckValidation not implemented")
+}
+func (UnimplementedABitOfEverythingServiceServer) InternalOnly(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalOnly not implemented")
+}
 
 // UnsafeABitOfEverythingServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ABitOfEverythingServiceServer will
@@ -814,6 +845,42 @@ func _ABitOfEverythingService_CheckExternalNestedPathEnum_Handler(srv interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ABitOfEverythingService_CheckValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABitOfEverythingServiceServer).CheckValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/CheckValidation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABitOfEverythingServiceServer).CheckValidation(ctx, req.(*ValidatedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ABitOfEverythingService_InternalOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABitOfEverythingServiceServer).InternalOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jsonrpc.gateway.test.proto.everything.ABitOfEverythingService/InternalOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABitOfEverythingServiceServer).InternalOnly(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ABitOfEverythingService_ServiceDesc is the grpc.ServiceDesc for ABitOfEverythingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -913,6 +980,14 @@ var ABitOfEverythingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckExternalNestedPathEnum",
 			Handler:    _ABitOfEverythingService_CheckExternalNestedPathEnum_Handler,
 		},
+		{
+			MethodName: "CheckValidation",
+			Handler:    _ABitOfEverythingService_CheckValidation_Handler,
+		},
+		{
+			MethodName: "InternalOnly",
+			Handler:    _ABitOfEverythingService_InternalOnly_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "test/proto/everything/a_bit_of_everything.proto",
@@ -33,42 +33,54 @@ var _ = metadata.Join
 var _ = json.Marshal
 var _ = jsonrpc.NewHTTPServerConn
 
-func request_Greet_Hello_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client GreetClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_Greet_Hello_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client GreetClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq HelloRequest
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.Hello(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_Greet_SendMyGift_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client GreetClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_Greet_SendMyGift_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client GreetClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq SendMyGiftRequest
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.SendMyGift(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_Greet_Hello2_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client GreetClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_Greet_Hello2_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client GreetClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq HelloRequest
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.Hello2(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
 
-func request_AnotherServiceWithNoBindings_NoBindings_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client AnotherServiceWithNoBindingsClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_AnotherServiceWithNoBindings_NoBindings_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client AnotherServiceWithNoBindingsClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq emptypb.Empty
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.NoBindings(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
@@ -111,7 +123,7 @@ func RegisterGreetJSONRPCHandler(ctx context.Context, mux *jsonrpc.ServeMux, con
 // "GreetClient" to call the correct interceptors.
 func RegisterGreetJSONRPCHandlerClient(ctx context.Context, mux *jsonrpc.ServeMux, client GreetClient) error {
 
-	mux.Register("Hello", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("Hello", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -119,20 +131,12 @@ func RegisterGreetJSONRPCHandlerClient(ctx context.Context, mux *jsonrpc.ServeMu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_Greet_Hello_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_Greet_Hello_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/proto.Greet/Hello"))
 
-	mux.Register("SendMyGift", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("SendMyGift", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -140,20 +144,12 @@ func RegisterGreetJSONRPCHandlerClient(ctx context.Context, mux *jsonrpc.ServeMu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_Greet_SendMyGift_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_Greet_SendMyGift_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/proto.Greet/SendMyGift"))
 
-	mux.Register("Hello2", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("Hello2", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -161,18 +157,10 @@ func RegisterGreetJSONRPCHandlerClient(ctx context.Context, mux *jsonrpc.ServeMu
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_Greet_Hello2_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_Greet_Hello2_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/proto.Greet/Hello2"))
 
 	return nil
 }
@@ -215,7 +203,7 @@ func RegisterAnotherServiceWithNoBindingsJSONRPCHandler(ctx context.Context, mux
 // "AnotherServiceWithNoBindingsClient" to call the correct interceptors.
 func RegisterAnotherServiceWithNoBindingsJSONRPCHandlerClient(ctx context.Context, mux *jsonrpc.ServeMux, client AnotherServiceWithNoBindingsClient) error {
 
-	mux.Register("NoBindings", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("NoBindings", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -223,18 +211,74 @@ func RegisterAnotherServiceWithNoBindingsJSONRPCHandlerClient(ctx context.Contex
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_AnotherServiceWithNoBindings_NoBindings_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_AnotherServiceWithNoBindings_NoBindings_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/proto.AnotherServiceWithNoBindings/NoBindings"))
 
 	return nil
 }
+
+// GreetJSONRPCClient is a GreetClient calling the methods of service Greet
+// registered on a jsonrpc.ServeMux, so that callers can switch between gRPC and JSON-RPC transparently.
+// Streaming methods fail with codes.Unimplemented.
+type GreetJSONRPCClient struct {
+	cc *jsonrpc.Client
+}
+
+var _ GreetClient = (*GreetJSONRPCClient)(nil)
+
+// NewGreetJSONRPCClient returns a GreetJSONRPCClient sending calls through "cc".
+func NewGreetJSONRPCClient(cc *jsonrpc.Client) *GreetJSONRPCClient {
+	return &GreetJSONRPCClient{cc: cc}
+}
+
+func (c *GreetJSONRPCClient) Hello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloResponse, error) {
+	out := new(HelloResponse)
+	if err := c.cc.Call(ctx, "Hello", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *GreetJSONRPCClient) SendMyGift(ctx context.Context, in *SendMyGiftRequest, opts ...grpc.CallOption) (*SendMyGiftResponse, error) {
+	out := new(SendMyGiftResponse)
+	if err := c.cc.Call(ctx, "SendMyGift", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *GreetJSONRPCClient) Hello2(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloResponse, error) {
+	out := new(HelloResponse)
+	if err := c.cc.Call(ctx, "Hello2", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *GreetJSONRPCClient) HelloStream(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (Greet_HelloStreamClient, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HelloStream is not served over JSON-RPC")
+}
+
+// AnotherServiceWithNoBindingsJSONRPCClient is a AnotherServiceWithNoBindingsClient calling the methods of service AnotherServiceWithNoBindings
+// registered on a jsonrpc.ServeMux, so that callers can switch between gRPC and JSON-RPC transparently.
+// Streaming methods fail with codes.Unimplemented.
+type AnotherServiceWithNoBindingsJSONRPCClient struct {
+	cc *jsonrpc.Client
+}
+
+var _ AnotherServiceWithNoBindingsClient = (*AnotherServiceWithNoBindingsJSONRPCClient)(nil)
+
+// NewAnotherServiceWithNoBindingsJSONRPCClient returns a AnotherServiceWithNoBindingsJSONRPCClient sending calls through "cc".
+func NewAnotherServiceWithNoBindingsJSONRPCClient(cc *jsonrpc.Client) *AnotherServiceWithNoBindingsJSONRPCClient {
+	return &AnotherServiceWithNoBindingsJSONRPCClient{cc: cc}
+}
+
+func (c *AnotherServiceWithNoBindingsJSONRPCClient) NoBindings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	if err := c.cc.Call(ctx, "NoBindings", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
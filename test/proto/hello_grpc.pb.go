@@ -23,6 +23,8 @@ type GreetClient interface {
 	Hello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloResponse, error)
 	SendMyGift(ctx context.Context, in *SendMyGiftRequest, opts ...grpc.CallOption) (*SendMyGiftResponse, error)
 	Hello2(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloResponse, error)
+	// hello stream, not served by the gateway
+	HelloStream(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (Greet_HelloStreamClient, error)
 }
 
 type greetClient struct {
@@ -60,6 +62,38 @@ func (c *greetClient) Hello2(ctx context.Context, in *HelloRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *greetClient) HelloStream(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (Greet_HelloStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Greet_ServiceDesc.Streams[0], "/proto.Greet/HelloStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &greetHelloStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Greet_HelloStreamClient interface {
+	Recv() (*HelloResponse, error)
+	grpc.ClientStream
+}
+
+type greetHelloStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *greetHelloStreamClient) Recv() (*HelloResponse, error) {
+	m := new(HelloResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // GreetServer is the server API for Greet service.
 // All implementations should embed UnimplementedGreetServer
 // for forward compatibility
@@ -68,6 +102,8 @@ type GreetServer interface {
 	Hello(context.Context, *HelloRequest) (*HelloResponse, error)
 	SendMyGift(context.Context, *SendMyGiftRequest) (*SendMyGiftResponse, error)
 	Hello2(context.Context, *HelloRequest) (*HelloResponse, error)
+	// hello stream, not served by the gateway
+	HelloStream(*HelloRequest, Greet_HelloStreamServer) error
 }
 
 // UnimplementedGreetServer should be embedded to have forward compatible implementations.
@@ -83,6 +119,9 @@ func (UnimplementedGreetServer) SendMyGift(context.Context, *SendMyGiftRequest)
 func (UnimplementedGreetServer) Hello2(context.Context, *HelloRequest) (*HelloResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Hello2 not implemented")
 }
+func (UnimplementedGreetServer) HelloStream(*HelloRequest, Greet_HelloStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method HelloStream not implemented")
+}
 
 // UnsafeGreetServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to GreetServer will
@@ -149,6 +188,27 @@ func _Greet_Hello2_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Greet_HelloStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(HelloRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GreetServer).HelloStream(m, &greetHelloStreamServer{stream})
+}
+
+type Greet_HelloStreamServer interface {
+	Send(*HelloResponse) error
+	grpc.ServerStream
+}
+
+type greetHelloStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *greetHelloStreamServer) Send(m *HelloResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Greet_ServiceDesc is the grpc.ServiceDesc for Greet service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -169,7 +229,13 @@ var Greet_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Greet_Hello2_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "HelloStream",
+			Handler:       _Greet_HelloStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "test/proto/hello.proto",
 }
 
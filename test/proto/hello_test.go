@@ -0,0 +1,123 @@
+package proto
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/yxlimo/go-jsonrpc-gateway/jsonrpc"
+)
+
+// deadlineGreetClient answers Hello with the time left before the deadline of
+// the call, as seen by the gRPC backend.
+type deadlineGreetClient struct {
+	GreetClient
+}
+
+func (deadlineGreetClient) Hello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloResponse, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return &HelloResponse{}, nil
+	}
+	return &HelloResponse{Message: time.Until(deadline).String()}, nil
+}
+
+func TestGreetHandlerTimeout(t *testing.T) {
+	for i, spec := range []struct {
+		header  string
+		timeout string
+
+		min, max time.Duration
+	}{
+		{
+			header: "1S",
+			min:    500 * time.Millisecond,
+			max:    time.Second,
+		},
+		{
+			header:  "100m",
+			timeout: "10s",
+			min:     9 * time.Second,
+			max:     10 * time.Second,
+		},
+		{
+			header:  "10S",
+			timeout: "100ms",
+			max:     100 * time.Millisecond,
+		},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			mux := jsonrpc.NewServeMux()
+			assert.NoError(t, RegisterGreetJSONRPCHandlerClient(context.Background(), mux, deadlineGreetClient{}))
+
+			body := `{"jsonrpc": "2.0", "method": "Hello", "id": 1, "params": {}`
+			if spec.timeout != "" {
+				body += `, "timeout": "` + spec.timeout + `"`
+			}
+			body += `}`
+			r := httptest.NewRequest("POST", "https://host.example/", bytes.NewReader([]byte(body)))
+			r.Header.Set("Content-Type", "application/json")
+			r.Header.Set("Grpc-Timeout", spec.header)
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+
+			assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+			var got struct {
+				Result struct {
+					Message string `json:"message"`
+				} `json:"result"`
+			}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+			remaining, err := time.ParseDuration(got.Result.Message)
+			assert.NoError(t, err)
+			assert.True(t, remaining > spec.min && remaining <= spec.max, "remaining %v, want in (%v, %v]", remaining, spec.min, spec.max)
+		})
+	}
+}
+
+// headerTransport sets header on every request, as a proxy in front of the
+// mux could.
+type headerTransport http.Header
+
+func (h headerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	for k, v := range h {
+		r.Header[k] = v
+	}
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestGreetClientDeadline(t *testing.T) {
+	for i, spec := range []struct {
+		header http.Header
+	}{
+		{},
+		{header: http.Header{"Grpc-Timeout": {"100m"}}},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			mux := jsonrpc.NewServeMux()
+			assert.NoError(t, RegisterGreetJSONRPCHandlerClient(context.Background(), mux, deadlineGreetClient{}))
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			cc := jsonrpc.NewClient(server.URL, jsonrpc.WithHTTPClient(&http.Client{Transport: headerTransport(spec.header)}))
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			got, err := NewGreetJSONRPCClient(cc).Hello(ctx, &HelloRequest{})
+			if !assert.NoError(t, err) {
+				return
+			}
+			remaining, err := time.ParseDuration(got.GetMessage())
+			assert.NoError(t, err)
+			assert.True(t, remaining > 4*time.Second && remaining <= 5*time.Second, "remaining %v, want the client deadline", remaining)
+		})
+	}
+}
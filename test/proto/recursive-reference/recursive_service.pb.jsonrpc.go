@@ -32,12 +32,15 @@ var _ = metadata.Join
 var _ = json.Marshal
 var _ = jsonrpc.NewHTTPServerConn
 
-func request_Recursive_RecursiveCall_jsonrpc(ctx context.Context, marshaler runtime.Marshaler, client RecursiveClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
+func request_Recursive_RecursiveCall_jsonrpc(ctx context.Context, mux *jsonrpc.ServeMux, marshaler runtime.Marshaler, client RecursiveClient, raw json.RawMessage) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq FooRequest
 	var metadata runtime.ServerMetadata
 	if err := marshaler.NewDecoder(bytes.NewReader(raw)).Decode(&protoReq); err != nil && err != io.EOF {
 		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	if err := mux.ValidateRequest(ctx, &protoReq); err != nil {
+		return nil, metadata, err
+	}
 	msg, err := client.RecursiveCall(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
@@ -80,7 +83,7 @@ func RegisterRecursiveJSONRPCHandler(ctx context.Context, mux *jsonrpc.ServeMux,
 // "RecursiveClient" to call the correct interceptors.
 func RegisterRecursiveJSONRPCHandlerClient(ctx context.Context, mux *jsonrpc.ServeMux, client RecursiveClient) error {
 
-	mux.Register("RecursiveCall", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (json.RawMessage, context.Context, error) {
+	mux.Register("RecursiveCall", func(req *http.Request, marshaller runtime.Marshaler, rawBody json.RawMessage) (proto.Message, context.Context, error) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var err error
@@ -88,18 +91,32 @@ func RegisterRecursiveJSONRPCHandlerClient(ctx context.Context, mux *jsonrpc.Ser
 		if err != nil {
 			return nil, ctx, err
 		}
-		resp, md, err := request_Recursive_RecursiveCall_jsonrpc(ctx, marshaller, client, rawBody)
+		resp, md, err := request_Recursive_RecursiveCall_jsonrpc(ctx, mux, marshaller, client, rawBody)
 		ctx = runtime.NewServerMetadataContext(ctx, md)
-		if err != nil {
-			return nil, ctx, err
-		}
-		rawResp, err := marshaller.Marshal(resp)
-		if err != nil {
-			return nil, ctx, err
-		}
-		return rawResp, ctx, nil
-
-	})
+		return resp, ctx, err
+	}, jsonrpc.WithGRPCMethod("/jsonrpc.gateway.test.proto.recursive_reference.Recursive/RecursiveCall"))
 
 	return nil
 }
+
+// RecursiveJSONRPCClient is a RecursiveClient calling the methods of service Recursive
+// registered on a jsonrpc.ServeMux, so that callers can switch between gRPC and JSON-RPC transparently.
+// Streaming methods fail with codes.Unimplemented.
+type RecursiveJSONRPCClient struct {
+	cc *jsonrpc.Client
+}
+
+var _ RecursiveClient = (*RecursiveJSONRPCClient)(nil)
+
+// NewRecursiveJSONRPCClient returns a RecursiveJSONRPCClient sending calls through "cc".
+func NewRecursiveJSONRPCClient(cc *jsonrpc.Client) *RecursiveJSONRPCClient {
+	return &RecursiveJSONRPCClient{cc: cc}
+}
+
+func (c *RecursiveJSONRPCClient) RecursiveCall(ctx context.Context, in *FooRequest, opts ...grpc.CallOption) (*FooResponse, error) {
+	out := new(FooResponse)
+	if err := c.cc.Call(ctx, "RecursiveCall", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
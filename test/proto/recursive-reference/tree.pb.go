@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: test/proto/recursive-reference/tree.proto
+
+package recursive_reference
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Tree references recursive messages through nested messages and map values.
+type Tree struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NodeById map[string]*Tree_Node `protobuf:"bytes,2,rep,name=node_by_id,json=nodeById,proto3" json:"node_by_id,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Tree) Reset() {
+	*x = Tree{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_test_proto_recursive_reference_tree_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tree) ProtoMessage() {}
+
+func (x *Tree) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proto_recursive_reference_tree_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tree.ProtoReflect.Descriptor instead.
+func (*Tree) Descriptor() ([]byte, []int) {
+	return file_test_proto_recursive_reference_tree_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tree) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tree) GetNodeById() map[string]*Tree_Node {
+	if x != nil {
+		return x.NodeById
+	}
+	return nil
+}
+
+// Node is a tree of nested messages.
+type Tree_Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Foo            *Foo                  `protobuf:"bytes,1,opt,name=foo,proto3" json:"foo,omitempty"`
+	Children       []*Tree_Node          `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	ChildrenByName map[string]*Tree_Node `protobuf:"bytes,3,rep,name=children_by_name,json=childrenByName,proto3" json:"children_by_name,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Tree_Node) Reset() {
+	*x = Tree_Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_test_proto_recursive_reference_tree_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tree_Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tree_Node) ProtoMessage() {}
+
+func (x *Tree_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proto_recursive_reference_tree_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tree_Node.ProtoReflect.Descriptor instead.
+func (*Tree_Node) Descriptor() ([]byte, []int) {
+	return file_test_proto_recursive_reference_tree_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Tree_Node) GetFoo() *Foo {
+	if x != nil {
+		return x.Foo
+	}
+	return nil
+}
+
+func (x *Tree_Node) GetChildren() []*Tree_Node {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Tree_Node) GetChildrenByName() map[string]*Tree_Node {
+	if x != nil {
+		return x.ChildrenByName
+	}
+	return nil
+}
+
+var File_test_proto_recursive_reference_tree_proto protoreflect.FileDescriptor
+
+var file_test_proto_recursive_reference_tree_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x63,
+	0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x2d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2f, 0x74, 0x72, 0x65, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x2e, 0x6a, 0x73, 0x6f,
+	0x6e, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76,
+	0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76,
+	0x65, 0x2d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x72, 0x65, 0x63, 0x75,
+	0x72, 0x73, 0x69, 0x76, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x05, 0x0a, 0x04,
+	0x54, 0x72, 0x65, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x60, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x62, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x72,
+	0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x5f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x42, 0x79, 0x49, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6e, 0x6f,
+	0x64, 0x65, 0x42, 0x79, 0x49, 0x64, 0x1a, 0x76, 0x0a, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79,
+	0x49, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4f, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x72,
+	0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x5f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x9b,
+	0x03, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x45, 0x0a, 0x03, 0x66, 0x6f, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x46, 0x6f, 0x6f, 0x52, 0x03, 0x66, 0x6f, 0x6f, 0x12, 0x55,
+	0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x39, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x65,
+	0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x77, 0x0a, 0x10, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x5f, 0x62, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x4d, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x65, 0x63,
+	0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x54, 0x72, 0x65, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x7c,
+	0x0a, 0x13, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x45, 0x5a, 0x43,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x78, 0x6c, 0x69, 0x6d,
+	0x6f, 0x2f, 0x67, 0x6f, 0x2d, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x2d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_test_proto_recursive_reference_tree_proto_rawDescOnce sync.Once
+	file_test_proto_recursive_reference_tree_proto_rawDescData = file_test_proto_recursive_reference_tree_proto_rawDesc
+)
+
+func file_test_proto_recursive_reference_tree_proto_rawDescGZIP() []byte {
+	file_test_proto_recursive_reference_tree_proto_rawDescOnce.Do(func() {
+		file_test_proto_recursive_reference_tree_proto_rawDescData = protoimpl.X.CompressGZIP(file_test_proto_recursive_reference_tree_proto_rawDescData)
+	})
+	return file_test_proto_recursive_reference_tree_proto_rawDescData
+}
+
+var file_test_proto_recursive_reference_tree_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_test_proto_recursive_reference_tree_proto_goTypes = []interface{}{
+	(*Tree)(nil),      // 0: jsonrpc.gateway.test.proto.recursive_reference.Tree
+	nil,               // 1: jsonrpc.gateway.test.proto.recursive_reference.Tree.NodeByIdEntry
+	(*Tree_Node)(nil), // 2: jsonrpc.gateway.test.proto.recursive_reference.Tree.Node
+	nil,               // 3: jsonrpc.gateway.test.proto.recursive_reference.Tree.Node.ChildrenByNameEntry
+	(*Foo)(nil),       // 4: jsonrpc.gateway.test.proto.recursive_reference.Foo
+}
+var file_test_proto_recursive_reference_tree_proto_depIdxs = []int32{
+	1, // 0: jsonrpc.gateway.test.proto.recursive_reference.Tree.node_by_id:type_name -> jsonrpc.gateway.test.proto.recursive_reference.Tree.NodeByIdEntry
+	2, // 1: jsonrpc.gateway.test.proto.recursive_reference.Tree.NodeByIdEntry.value:type_name -> jsonrpc.gateway.test.proto.recursive_reference.Tree.Node
+	4, // 2: jsonrpc.gateway.test.proto.recursive_reference.Tree.Node.foo:type_name -> jsonrpc.gateway.test.proto.recursive_reference.Foo
+	2, // 3: jsonrpc.gateway.test.proto.recursive_reference.Tree.Node.children:type_name -> jsonrpc.gateway.test.proto.recursive_reference.Tree.Node
+	3, // 4: jsonrpc.gateway.test.proto.recursive_reference.Tree.Node.children_by_name:type_name -> jsonrpc.gateway.test.proto.recursive_reference.Tree.Node.ChildrenByNameEntry
+	2, // 5: jsonrpc.gateway.test.proto.recursive_reference.Tree.Node.ChildrenByNameEntry.value:type_name -> jsonrpc.gateway.test.proto.recursive_reference.Tree.Node
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_test_proto_recursive_reference_tree_proto_init() }
+func file_test_proto_recursive_reference_tree_proto_init() {
+	if File_test_proto_recursive_reference_tree_proto != nil {
+		return
+	}
+	file_test_proto_recursive_reference_recursive_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_test_proto_recursive_reference_tree_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tree); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_test_proto_recursive_reference_tree_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tree_Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_test_proto_recursive_reference_tree_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_proto_recursive_reference_tree_proto_goTypes,
+		DependencyIndexes: file_test_proto_recursive_reference_tree_proto_depIdxs,
+		MessageInfos:      file_test_proto_recursive_reference_tree_proto_msgTypes,
+	}.Build()
+	File_test_proto_recursive_reference_tree_proto = out.File
+	file_test_proto_recursive_reference_tree_proto_rawDesc = nil
+	file_test_proto_recursive_reference_tree_proto_goTypes = nil
+	file_test_proto_recursive_reference_tree_proto_depIdxs = nil
+}
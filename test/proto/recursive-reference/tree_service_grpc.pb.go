@@ -0,0 +1,99 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package recursive_reference
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TreeServiceClient is the client API for TreeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TreeServiceClient interface {
+	GetTree(ctx context.Context, in *TreeRequest, opts ...grpc.CallOption) (*Tree, error)
+}
+
+type treeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTreeServiceClient(cc grpc.ClientConnInterface) TreeServiceClient {
+	return &treeServiceClient{cc}
+}
+
+func (c *treeServiceClient) GetTree(ctx context.Context, in *TreeRequest, opts ...grpc.CallOption) (*Tree, error) {
+	out := new(Tree)
+	err := c.cc.Invoke(ctx, "/jsonrpc.gateway.test.proto.recursive_reference.TreeService/GetTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TreeServiceServer is the server API for TreeService service.
+// All implementations should embed UnimplementedTreeServiceServer
+// for forward compatibility
+type TreeServiceServer interface {
+	GetTree(context.Context, *TreeRequest) (*Tree, error)
+}
+
+// UnimplementedTreeServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedTreeServiceServer struct {
+}
+
+func (UnimplementedTreeServiceServer) GetTree(context.Context, *TreeRequest) (*Tree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTree not implemented")
+}
+
+// UnsafeTreeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TreeServiceServer will
+// result in compilation errors.
+type UnsafeTreeServiceServer interface {
+	mustEmbedUnimplementedTreeServiceServer()
+}
+
+func RegisterTreeServiceServer(s grpc.ServiceRegistrar, srv TreeServiceServer) {
+	s.RegisterService(&TreeService_ServiceDesc, srv)
+}
+
+func _TreeService_GetTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).GetTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jsonrpc.gateway.test.proto.recursive_reference.TreeService/GetTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).GetTree(ctx, req.(*TreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TreeService_ServiceDesc is the grpc.ServiceDesc for TreeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TreeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "jsonrpc.gateway.test.proto.recursive_reference.TreeService",
+	HandlerType: (*TreeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTree",
+			Handler:    _TreeService_GetTree_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "test/proto/recursive-reference/tree_service.proto",
+}